@@ -25,6 +25,12 @@ void InitUndistortRectifyMap(Mat cameraMatrix,Mat distCoeffs,Mat r,Mat newCamera
     cv::initUndistortRectifyMap(*cameraMatrix,*distCoeffs,*r,*newCameraMatrix,sz,m1type,*map1,*map2);
 }
 
+float InitWideAngleProjMap(Mat cameraMatrix, Mat distCoeffs, Size imageSize, int destImageWidth, int m1type, Mat map1, Mat map2, int projType, double alpha) {
+    cv::Size sz(imageSize.width, imageSize.height);
+    return cv::initWideAngleProjMap(*cameraMatrix, *distCoeffs, sz, destImageWidth, m1type, *map1, *map2,
+        static_cast<cv::UndistortTypes>(projType), alpha);
+}
+
 Mat GetOptimalNewCameraMatrixWithParams(Mat cameraMatrix,Mat distCoeffs,Size size,double alpha,Size newImgSize,Rect* validPixROI,bool centerPrincipalPoint) {
     cv::Size sz(size.width, size.height);
     cv::Size newSize(newImgSize.width, newImgSize.height);
@@ -55,6 +61,213 @@ void DrawChessboardCorners(Mat image, Size patternSize, Mat corners, bool patter
     cv::drawChessboardCorners(*image, sz, *corners, patternWasFound);
 }
 
+static std::vector<cv::Mat> toMatVector(struct Mats mats) {
+    std::vector<cv::Mat> v;
+    for (int i = 0; i < mats.length; ++i) {
+        v.push_back(*mats.mats[i]);
+    }
+    return v;
+}
+
+double CalibrateCamera(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, int flags, TermCriteria criteria) {
+    cv::Size sz(imageSize.width, imageSize.height);
+    return cv::calibrateCamera(toMatVector(objectPoints), toMatVector(imagePoints), sz, *cameraMatrix, *distCoeffs,
+        *rvecs, *tvecs, flags, *criteria);
+}
+
+double CalibrateCameraExtended(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, Mat stdDevsIntrinsics, Mat stdDevsExtrinsics, Mat perViewErrors, int flags, TermCriteria criteria) {
+    cv::Size sz(imageSize.width, imageSize.height);
+    return cv::calibrateCamera(toMatVector(objectPoints), toMatVector(imagePoints), sz, *cameraMatrix, *distCoeffs,
+        *rvecs, *tvecs, *stdDevsIntrinsics, *stdDevsExtrinsics, *perViewErrors, flags, *criteria);
+}
+
+double CalibrateCameraRO(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, int iFixedPoint, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, Mat newObjPoints, int flags, TermCriteria criteria) {
+    cv::Size sz(imageSize.width, imageSize.height);
+    return cv::calibrateCameraRO(toMatVector(objectPoints), toMatVector(imagePoints), sz, iFixedPoint, *cameraMatrix,
+        *distCoeffs, *rvecs, *tvecs, *newObjPoints, flags, *criteria);
+}
+
+void CalibrationMatrixValues(Mat cameraMatrix, Size imageSize, double apertureWidth, double apertureHeight, double* fovx, double* fovy, double* focalLength, Point2f* principalPoint, double* aspectRatio) {
+    cv::Size sz(imageSize.width, imageSize.height);
+    cv::Point2d pp;
+    cv::calibrationMatrixValues(*cameraMatrix, sz, apertureWidth, apertureHeight, *fovx, *fovy, *focalLength, pp, *aspectRatio);
+    principalPoint->x = pp.x;
+    principalPoint->y = pp.y;
+}
+
 Mat EstimateAffinePartial2D(Point2fVector from, Point2fVector to) {
     return new cv::Mat(cv::estimateAffinePartial2D(*from, *to));
 }
+
+static std::vector<cv::Point3f> toPoint3fVector(Points3f points) {
+    std::vector<cv::Point3f> v;
+    for (int i = 0; i < points.length; ++i) {
+        v.push_back(cv::Point3f(points.points[i].x, points.points[i].y, points.points[i].z));
+    }
+    return v;
+}
+
+static std::vector<cv::Point2f> toPoint2fVector(Points2f points) {
+    std::vector<cv::Point2f> v;
+    for (int i = 0; i < points.length; ++i) {
+        v.push_back(cv::Point2f(points.points[i].x, points.points[i].y));
+    }
+    return v;
+}
+
+static void toMats(const std::vector<cv::Mat>& v, struct Mats* mats) {
+    mats->mats = new Mat[v.size()];
+    for (size_t i = 0; i < v.size(); ++i) {
+        mats->mats[i] = new cv::Mat(v[i]);
+    }
+    mats->length = (int)v.size();
+}
+
+bool SolvePnP(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, bool useExtrinsicGuess, int flags) {
+    return cv::solvePnP(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        *rvec, *tvec, useExtrinsicGuess, flags);
+}
+
+bool SolvePnPRansac(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, bool useExtrinsicGuess, int iterationsCount, float reprojectionError, double confidence, Mat inliers, int flags) {
+    return cv::solvePnPRansac(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        *rvec, *tvec, useExtrinsicGuess, iterationsCount, reprojectionError, confidence, *inliers, flags);
+}
+
+void SolvePnPGeneric(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, struct Mats* rvecs, struct Mats* tvecs, bool useExtrinsicGuess, int flags, Mat rvec, Mat tvec, Mat reprojectionError) {
+    std::vector<cv::Mat> rv, tv;
+    cv::solvePnPGeneric(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        rv, tv, useExtrinsicGuess, static_cast<cv::SolvePnPMethod>(flags), *rvec, *tvec, *reprojectionError);
+    toMats(rv, rvecs);
+    toMats(tv, tvecs);
+}
+
+void SolvePnPRefineLM(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, TermCriteria criteria) {
+    cv::solvePnPRefineLM(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        *rvec, *tvec, *criteria);
+}
+
+void SolvePnPRefineVVS(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, TermCriteria criteria, double VVSlambda) {
+    cv::solvePnPRefineVVS(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        *rvec, *tvec, *criteria, VVSlambda);
+}
+
+void SolveP3P(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, struct Mats* rvecs, struct Mats* tvecs, int flags) {
+    std::vector<cv::Mat> rv, tv;
+    cv::solveP3P(toPoint3fVector(objectPoints), toPoint2fVector(imagePoints), *cameraMatrix, *distCoeffs,
+        rv, tv, flags);
+    toMats(rv, rvecs);
+    toMats(tv, tvecs);
+}
+
+void Rodrigues(Mat src, Mat dst) {
+    cv::Rodrigues(*src, *dst);
+}
+
+void RodriguesWithJacobian(Mat src, Mat dst, Mat jacobian) {
+    cv::Rodrigues(*src, *dst, *jacobian);
+}
+
+Point2fVector ProjectPoints(Points3f objectPoints, Mat rvec, Mat tvec, Mat cameraMatrix, Mat distCoeffs) {
+    std::vector<cv::Point2f>* imagePoints = new std::vector<cv::Point2f>;
+    cv::projectPoints(toPoint3fVector(objectPoints), *rvec, *tvec, *cameraMatrix, *distCoeffs, *imagePoints);
+    return imagePoints;
+}
+
+Point2fVector ProjectPointsWithJacobian(Points3f objectPoints, Mat rvec, Mat tvec, Mat cameraMatrix, Mat distCoeffs, Mat jacobian, double aspectRatio) {
+    std::vector<cv::Point2f>* imagePoints = new std::vector<cv::Point2f>;
+    cv::projectPoints(toPoint3fVector(objectPoints), *rvec, *tvec, *cameraMatrix, *distCoeffs, *imagePoints,
+        *jacobian, aspectRatio);
+    return imagePoints;
+}
+
+Mat FindFundamentalMat(Points2f points1, Points2f points2, int method, double ransacReprojThreshold, double confidence, int maxIters, Mat mask) {
+    return new cv::Mat(cv::findFundamentalMat(toPoint2fVector(points1), toPoint2fVector(points2), method,
+        ransacReprojThreshold, confidence, maxIters, *mask));
+}
+
+Mat FindEssentialMat(Points2f points1, Points2f points2, Mat cameraMatrix, int method, double prob, double threshold, Mat mask) {
+    return new cv::Mat(cv::findEssentialMat(toPoint2fVector(points1), toPoint2fVector(points2), *cameraMatrix, method,
+        prob, threshold, *mask));
+}
+
+int RecoverPose(Mat E, Points2f points1, Points2f points2, Mat cameraMatrix, Mat R, Mat t, Mat mask) {
+    return cv::recoverPose(*E, toPoint2fVector(points1), toPoint2fVector(points2), *cameraMatrix, *R, *t, *mask);
+}
+
+void DecomposeEssentialMat(Mat E, Mat R1, Mat R2, Mat t) {
+    cv::decomposeEssentialMat(*E, *R1, *R2, *t);
+}
+
+void ComputeCorrespondEpilines(Points2f points, int whichImage, Mat F, Mat lines) {
+    cv::computeCorrespondEpilines(toPoint2fVector(points), whichImage, *F, *lines);
+}
+
+void CorrectMatches(Mat F, Points2f points1, Points2f points2, Point2fVector newPoints1, Point2fVector newPoints2) {
+    // correctMatches only accepts 1xN double precision points.
+    cv::Mat p1, p2, np1, np2;
+    cv::Mat(toPoint2fVector(points1)).reshape(2, 1).convertTo(p1, CV_64FC2);
+    cv::Mat(toPoint2fVector(points2)).reshape(2, 1).convertTo(p2, CV_64FC2);
+
+    cv::correctMatches(*F, p1, p2, np1, np2);
+
+    cv::Mat out1, out2;
+    np1.convertTo(out1, CV_32FC2);
+    np2.convertTo(out2, CV_32FC2);
+    newPoints1->assign(out1.begin<cv::Point2f>(), out1.end<cv::Point2f>());
+    newPoints2->assign(out2.begin<cv::Point2f>(), out2.end<cv::Point2f>());
+}
+
+double SampsonDistance(Point2f pt1, Point2f pt2, Mat F) {
+    cv::Vec3d p1(pt1.x, pt1.y, 1);
+    cv::Vec3d p2(pt2.x, pt2.y, 1);
+    return cv::sampsonDistance(p1, p2, *F);
+}
+
+Mat EstimateAffine2D(Points2f from, Points2f to) {
+    return new cv::Mat(cv::estimateAffine2D(toPoint2fVector(from), toPoint2fVector(to)));
+}
+
+Mat EstimateAffine2DWithParams(Points2f from, Points2f to, Mat inliers, int method, double ransacReprojThreshold, int maxIters, double confidence, int refineIters) {
+    return new cv::Mat(cv::estimateAffine2D(toPoint2fVector(from), toPoint2fVector(to), *inliers, method,
+        ransacReprojThreshold, maxIters, confidence, refineIters));
+}
+
+int EstimateAffine3D(Points3f src, Points3f dst, Mat out, Mat inliers, double ransacThreshold, double confidence) {
+    return cv::estimateAffine3D(toPoint3fVector(src), toPoint3fVector(dst), *out, *inliers, ransacThreshold, confidence);
+}
+
+void DecomposeHomographyMat(Mat H, Mat K, struct Mats* rotations, struct Mats* translations, struct Mats* normals) {
+    std::vector<cv::Mat> rs, ts, ns;
+    cv::decomposeHomographyMat(*H, *K, rs, ts, ns);
+    toMats(rs, rotations);
+    toMats(ts, translations);
+    toMats(ns, normals);
+}
+
+void FilterHomographyDecompByVisibleRefpoints(struct Mats rotations, struct Mats normals, Points2f beforePoints, Points2f afterPoints, Mat possibleSolutions, Mat pointsMask) {
+    cv::filterHomographyDecompByVisibleRefpoints(toMatVector(rotations), toMatVector(normals), toPoint2fVector(beforePoints),
+        toPoint2fVector(afterPoints), *possibleSolutions, *pointsMask);
+}
+
+void DecomposeProjectionMatrix(Mat projMatrix, Mat cameraMatrix, Mat rotMatrix, Mat transVect) {
+    cv::decomposeProjectionMatrix(*projMatrix, *cameraMatrix, *rotMatrix, *transVect);
+}
+
+void DecomposeProjectionMatrixWithParams(Mat projMatrix, Mat cameraMatrix, Mat rotMatrix, Mat transVect, Mat rotMatrixX, Mat rotMatrixY, Mat rotMatrixZ, Mat eulerAngles) {
+    cv::decomposeProjectionMatrix(*projMatrix, *cameraMatrix, *rotMatrix, *transVect, *rotMatrixX, *rotMatrixY, *rotMatrixZ,
+        *eulerAngles);
+}
+
+void RQDecomp3x3(Mat src, Mat mtxR, Mat mtxQ, double* eulerAngles) {
+    cv::Vec3d angles = cv::RQDecomp3x3(*src, *mtxR, *mtxQ);
+    for (int i = 0; i < 3; ++i) {
+        eulerAngles[i] = angles[i];
+    }
+}
+
+void RQDecomp3x3WithParams(Mat src, Mat mtxR, Mat mtxQ, Mat qx, Mat qy, Mat qz, double* eulerAngles) {
+    cv::Vec3d angles = cv::RQDecomp3x3(*src, *mtxR, *mtxQ, *qx, *qy, *qz);
+    for (int i = 0; i < 3; ++i) {
+        eulerAngles[i] = angles[i];
+    }
+}
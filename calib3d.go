@@ -104,6 +104,32 @@ func InitUndistortRectifyMap(cameraMatrix Mat, distCoeffs Mat, r Mat, newCameraM
 	C.InitUndistortRectifyMap(cameraMatrix.Ptr(), distCoeffs.Ptr(), r.Ptr(), newCameraMatrix.Ptr(), sz, C.int(m1type), map1.Ptr(), map2.Ptr())
 }
 
+// UndistortTypes are the projections used by InitWideAngleProjMap.
+type UndistortTypes int
+
+const (
+	// ProjSphericalOrtho is the spherical orthographic projection.
+	ProjSphericalOrtho UndistortTypes = 0
+
+	// ProjSphericalEqrect is the spherical equirectangular projection.
+	ProjSphericalEqrect UndistortTypes = 1
+)
+
+// InitWideAngleProjMap initializes the maps for Remap for wide-angle images,
+// projecting them with projType to a destination image of width
+// destImageWidth. It returns the scale factor of the projection.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d54/group__imgproc__transform.html#gaceb049ec48898d1dadd5b50c604429c8
+//
+func InitWideAngleProjMap(cameraMatrix, distCoeffs Mat, imageSize image.Point, destImageWidth int, m1type MatType, map1, map2 *Mat, projType UndistortTypes, alpha float64) float32 {
+	sz := C.struct_Size{
+		width:  C.int(imageSize.X),
+		height: C.int(imageSize.Y),
+	}
+	return float32(C.InitWideAngleProjMap(cameraMatrix.p, distCoeffs.p, sz, C.int(destImageWidth), C.int(m1type), map1.p, map2.p, C.int(projType), C.double(alpha)))
+}
+
 // GetOptimalNewCameraMatrixWithParams computes and returns the optimal new camera matrix based on the free scaling parameter.
 //
 // For further details, please see:
@@ -181,6 +207,154 @@ func DrawChessboardCorners(image *Mat, patternSize image.Point, corners Mat, pat
 	C.DrawChessboardCorners(image.Ptr(), sz, corners.Ptr(), C.bool(patternWasFound))
 }
 
+// CalibCameraFlag is the set of flags used by CalibrateCamera and
+// CalibrateCameraRO for the pinhole camera model. Their values differ from
+// the fisheye CalibFlag values.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+type CalibCameraFlag int32
+
+const (
+	// CalibCameraUseIntrinsicGuess indicates that cameraMatrix contains valid initial
+	// values of fx, fy, cx, cy that are optimized further.
+	CalibCameraUseIntrinsicGuess CalibCameraFlag = 0x00001
+
+	// CalibCameraFixAspectRatio keeps the ratio fx/fy of the initial cameraMatrix.
+	CalibCameraFixAspectRatio CalibCameraFlag = 0x00002
+
+	// CalibCameraFixPrincipalPoint indicates that the principal point is not changed
+	// during the global optimization.
+	CalibCameraFixPrincipalPoint CalibCameraFlag = 0x00004
+
+	// CalibCameraZeroTangentDist sets the tangential distortion coefficients to zero.
+	CalibCameraZeroTangentDist CalibCameraFlag = 0x00008
+
+	// CalibCameraFixFocalLength keeps the focal length of the initial cameraMatrix.
+	CalibCameraFixFocalLength CalibCameraFlag = 0x00010
+
+	// CalibCameraFixK1 keeps the K1 distortion coefficient unchanged.
+	CalibCameraFixK1 CalibCameraFlag = 0x00020
+
+	// CalibCameraFixK2 keeps the K2 distortion coefficient unchanged.
+	CalibCameraFixK2 CalibCameraFlag = 0x00040
+
+	// CalibCameraFixK3 keeps the K3 distortion coefficient unchanged.
+	CalibCameraFixK3 CalibCameraFlag = 0x00080
+
+	// CalibCameraFixIntrinsic keeps cameraMatrix and distCoeffs unchanged, so that
+	// only the extrinsic parameters are estimated.
+	CalibCameraFixIntrinsic CalibCameraFlag = 0x00100
+
+	// CalibCameraFixK4 keeps the K4 distortion coefficient unchanged.
+	CalibCameraFixK4 CalibCameraFlag = 0x00800
+
+	// CalibCameraFixK5 keeps the K5 distortion coefficient unchanged.
+	CalibCameraFixK5 CalibCameraFlag = 0x01000
+
+	// CalibCameraFixK6 keeps the K6 distortion coefficient unchanged.
+	CalibCameraFixK6 CalibCameraFlag = 0x02000
+
+	// CalibCameraRationalModel enables the K4, K5 and K6 coefficients.
+	CalibCameraRationalModel CalibCameraFlag = 0x04000
+
+	// CalibCameraThinPrismModel enables the S1, S2, S3 and S4 coefficients.
+	CalibCameraThinPrismModel CalibCameraFlag = 0x08000
+
+	// CalibCameraFixS1S2S3S4 keeps the thin prism distortion coefficients unchanged.
+	CalibCameraFixS1S2S3S4 CalibCameraFlag = 0x10000
+
+	// CalibCameraTiltedModel enables the tauX and tauY coefficients.
+	CalibCameraTiltedModel CalibCameraFlag = 0x40000
+
+	// CalibCameraFixTauxTauy keeps the coefficients of the tilted sensor model unchanged.
+	CalibCameraFixTauxTauy CalibCameraFlag = 0x80000
+
+	// CalibCameraFixTangentDist keeps the tangential distortion coefficients unchanged.
+	CalibCameraFixTangentDist CalibCameraFlag = 0x200000
+)
+
+// CalibrateCamera finds the camera intrinsic and extrinsic parameters from
+// several views of a calibration pattern.
+//
+// Each element of objectPoints holds the pattern points of one view, in the
+// pattern coordinate space, as an Nx3 MatTypeCV32F or Nx1 MatTypeCV32FC3 Mat.
+// The matching element of imagePoints holds their projections, for example
+// the corners returned by FindChessboardCorners.
+//
+// On return, rvecs and tvecs hold one row per view with the rotation vector
+// and translation vector of that view. The returned value is the overall RMS
+// re-projection error.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func CalibrateCamera(objectPoints, imagePoints []Mat, imageSize image.Point, cameraMatrix, distCoeffs, rvecs, tvecs *Mat, flags CalibCameraFlag, criteria TermCriteria) float64 {
+	sz := C.struct_Size{
+		width:  C.int(imageSize.X),
+		height: C.int(imageSize.Y),
+	}
+	return float64(C.CalibrateCamera(toCMats(objectPoints), toCMats(imagePoints), sz, cameraMatrix.p, distCoeffs.p, rvecs.p, tvecs.p, C.int(flags), criteria.p))
+}
+
+// CalibrateCameraExtended is the same as CalibrateCamera, but also returns the
+// standard deviations estimated for the intrinsic and extrinsic parameters,
+// and the RMS re-projection error of each view.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func CalibrateCameraExtended(objectPoints, imagePoints []Mat, imageSize image.Point, cameraMatrix, distCoeffs, rvecs, tvecs, stdDevsIntrinsics, stdDevsExtrinsics, perViewErrors *Mat, flags CalibCameraFlag, criteria TermCriteria) float64 {
+	sz := C.struct_Size{
+		width:  C.int(imageSize.X),
+		height: C.int(imageSize.Y),
+	}
+	return float64(C.CalibrateCameraExtended(toCMats(objectPoints), toCMats(imagePoints), sz, cameraMatrix.p, distCoeffs.p, rvecs.p, tvecs.p,
+		stdDevsIntrinsics.p, stdDevsExtrinsics.p, perViewErrors.p, C.int(flags), criteria.p))
+}
+
+// CalibrateCameraRO finds the camera intrinsic and extrinsic parameters using
+// the release object method, which also refines the pattern points to make up
+// for an inaccurate calibration target.
+//
+// iFixedPoint is the index of the pattern point fixed together with the first
+// one, usually the top-right corner. A value outside [1, N-2] falls back to
+// the standard CalibrateCamera method. The refined pattern points are stored
+// in newObjPoints.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func CalibrateCameraRO(objectPoints, imagePoints []Mat, imageSize image.Point, iFixedPoint int, cameraMatrix, distCoeffs, rvecs, tvecs, newObjPoints *Mat, flags CalibCameraFlag, criteria TermCriteria) float64 {
+	sz := C.struct_Size{
+		width:  C.int(imageSize.X),
+		height: C.int(imageSize.Y),
+	}
+	return float64(C.CalibrateCameraRO(toCMats(objectPoints), toCMats(imagePoints), sz, C.int(iFixedPoint), cameraMatrix.p, distCoeffs.p, rvecs.p, tvecs.p,
+		newObjPoints.p, C.int(flags), criteria.p))
+}
+
+// CalibrationMatrixValues computes useful camera characteristics from the
+// camera intrinsic matrix, given the physical width and height of the sensor
+// in apertureWidth and apertureHeight. The field of view is in degrees, and
+// the focal length and principal point are in the aperture units.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func CalibrationMatrixValues(cameraMatrix Mat, imageSize image.Point, apertureWidth, apertureHeight float64) (fovx, fovy, focalLength float64, principalPoint Point2f, aspectRatio float64) {
+	sz := C.struct_Size{
+		width:  C.int(imageSize.X),
+		height: C.int(imageSize.Y),
+	}
+
+	var cFovx, cFovy, cFocalLength, cAspectRatio C.double
+	var cPrincipalPoint C.struct_Point2f
+	C.CalibrationMatrixValues(cameraMatrix.p, sz, C.double(apertureWidth), C.double(apertureHeight), &cFovx, &cFovy, &cFocalLength, &cPrincipalPoint, &cAspectRatio)
+
+	return float64(cFovx), float64(cFovy), float64(cFocalLength), fromCPoint2f(cPrincipalPoint), float64(cAspectRatio)
+}
+
 // EstimateAffinePartial2D computes an optimal limited affine transformation
 // with 4 degrees of freedom between two 2D point sets.
 //
@@ -189,3 +363,433 @@ func DrawChessboardCorners(image *Mat, patternSize image.Point, corners Mat, pat
 func EstimateAffinePartial2D(from, to Point2fVector) Mat {
 	return newMat(C.EstimateAffinePartial2D(from.p, to.p))
 }
+
+// EstimateAffine2D computes an optimal affine transformation between two 2D
+// point sets, using the RANSAC method.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func EstimateAffine2D(from, to []Point2f) Mat {
+	return newMat(C.EstimateAffine2D(toCPoints2f(from), toCPoints2f(to)))
+}
+
+// EstimateAffine2DWithParams computes an optimal affine transformation
+// between two 2D point sets, using method, which must be either
+// EstimationMethodRANSAC or EstimationMethodLMEDS. The points consistent with
+// the transformation are set to a non-zero value in inliers, and refineIters
+// is the maximum number of Levenberg-Marquardt refinement iterations, or 0 to
+// skip the refinement.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func EstimateAffine2DWithParams(from, to []Point2f, inliers *Mat, method EstimationMethod, ransacReprojThreshold float64, maxIters int, confidence float64, refineIters int) Mat {
+	return newMat(C.EstimateAffine2DWithParams(toCPoints2f(from), toCPoints2f(to), inliers.p, C.int(method), C.double(ransacReprojThreshold), C.int(maxIters), C.double(confidence), C.int(refineIters)))
+}
+
+// EstimateAffine3D computes an optimal affine transformation between two 3D
+// point sets, using the RANSAC method. The 3x4 transformation is stored in
+// out, and the points consistent with it are set to a non-zero value in
+// inliers. It returns false if no transformation was found.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func EstimateAffine3D(src, dst []Point3f, out, inliers *Mat, ransacThreshold, confidence float64) bool {
+	return C.EstimateAffine3D(toCPoints3f(src), toCPoints3f(dst), out.p, inliers.p, C.double(ransacThreshold), C.double(confidence)) != 0
+}
+
+// SolvePnPMethod is the method used by SolvePnP and its variants to solve a
+// Perspective-n-Point problem.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+type SolvePnPMethod int
+
+const (
+	// SolvePnPIterative uses a Levenberg-Marquardt optimization that minimizes
+	// the re-projection error.
+	SolvePnPIterative SolvePnPMethod = 0
+
+	// SolvePnPEPnP uses the EPnP method.
+	SolvePnPEPnP SolvePnPMethod = 1
+
+	// SolvePnPP3P uses the P3P method, which requires exactly 4 points.
+	SolvePnPP3P SolvePnPMethod = 2
+
+	// SolvePnPDLS uses the DLS method. Its implementation is broken in OpenCV,
+	// which falls back to SolvePnPEPnP instead.
+	SolvePnPDLS SolvePnPMethod = 3
+
+	// SolvePnPUPnP uses the UPnP method. Its implementation is broken in OpenCV,
+	// which falls back to SolvePnPEPnP instead.
+	SolvePnPUPnP SolvePnPMethod = 4
+
+	// SolvePnPAP3P uses the AP3P method, which requires exactly 4 points.
+	SolvePnPAP3P SolvePnPMethod = 5
+
+	// SolvePnPIPPE uses the IPPE method, which requires at least 4 coplanar
+	// object points.
+	SolvePnPIPPE SolvePnPMethod = 6
+
+	// SolvePnPIPPESquare is a variant of SolvePnPIPPE for square markers. It
+	// requires exactly the 4 corners of the marker, in a specific order.
+	SolvePnPIPPESquare SolvePnPMethod = 7
+)
+
+// SolvePnP finds an object pose from 3D-2D point correspondences. On return,
+// rvec and tvec hold the rotation vector and the translation vector that
+// bring points from the model coordinate system to the camera coordinate
+// system. If useExtrinsicGuess is true, their values are used as the initial
+// approximation.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolvePnP(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, rvec, tvec *Mat, useExtrinsicGuess bool, flags SolvePnPMethod) bool {
+	return bool(C.SolvePnP(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, rvec.p, tvec.p, C.bool(useExtrinsicGuess), C.int(flags)))
+}
+
+// SolvePnPRansac finds an object pose from 3D-2D point correspondences using
+// the RANSAC scheme, which makes it resistant to outliers. The indices of the
+// points consistent with the pose are stored in inliers.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolvePnPRansac(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, rvec, tvec *Mat, useExtrinsicGuess bool, iterationsCount int, reprojectionError float32, confidence float64, inliers *Mat, flags SolvePnPMethod) bool {
+	return bool(C.SolvePnPRansac(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, rvec.p, tvec.p, C.bool(useExtrinsicGuess),
+		C.int(iterationsCount), C.float(reprojectionError), C.double(confidence), inliers.p, C.int(flags)))
+}
+
+// SolvePnPGeneric finds an object pose from 3D-2D point correspondences, and
+// returns all the solutions found by the method, sorted by re-projection
+// error. The re-projection error of each solution is stored in
+// reprojectionError. rvec and tvec are only used as the initial approximation
+// for SolvePnPIterative when useExtrinsicGuess is true.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolvePnPGeneric(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, useExtrinsicGuess bool, flags SolvePnPMethod, rvec, tvec Mat, reprojectionError *Mat) (rvecs, tvecs []Mat) {
+	cRvecs := C.struct_Mats{}
+	cTvecs := C.struct_Mats{}
+	C.SolvePnPGeneric(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, &cRvecs, &cTvecs, C.bool(useExtrinsicGuess),
+		C.int(flags), rvec.p, tvec.p, reprojectionError.p)
+
+	return toGoMats(cRvecs), toGoMats(cTvecs)
+}
+
+// SolvePnPRefineLM refines a pose from 3D-2D point correspondences, starting
+// from the values of rvec and tvec, using a Levenberg-Marquardt iterative
+// minimization process.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolvePnPRefineLM(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, rvec, tvec *Mat, criteria TermCriteria) {
+	C.SolvePnPRefineLM(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, rvec.p, tvec.p, criteria.p)
+}
+
+// SolvePnPRefineVVS refines a pose from 3D-2D point correspondences, starting
+// from the values of rvec and tvec, using a virtual visual servoing scheme.
+// vvsLambda is the gain of the control law.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolvePnPRefineVVS(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, rvec, tvec *Mat, criteria TermCriteria, vvsLambda float64) {
+	C.SolvePnPRefineVVS(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, rvec.p, tvec.p, criteria.p, C.double(vvsLambda))
+}
+
+// SolveP3P finds an object pose from exactly 3 3D-2D point correspondences,
+// and returns all the possible solutions. flags must be SolvePnPP3P or
+// SolvePnPAP3P.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SolveP3P(objectPoints []Point3f, imagePoints []Point2f, cameraMatrix, distCoeffs Mat, flags SolvePnPMethod) (rvecs, tvecs []Mat) {
+	cRvecs := C.struct_Mats{}
+	cTvecs := C.struct_Mats{}
+	C.SolveP3P(toCPoints3f(objectPoints), toCPoints2f(imagePoints), cameraMatrix.p, distCoeffs.p, &cRvecs, &cTvecs, C.int(flags))
+
+	return toGoMats(cRvecs), toGoMats(cTvecs)
+}
+
+// Rodrigues converts a rotation vector to a rotation matrix, or a rotation
+// matrix to a rotation vector.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func Rodrigues(src Mat, dst *Mat) {
+	C.Rodrigues(src.p, dst.p)
+}
+
+// RodriguesWithJacobian is the same as Rodrigues, but also computes the
+// Jacobian matrix of partial derivatives of the output with respect to the
+// input.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func RodriguesWithJacobian(src Mat, dst, jacobian *Mat) {
+	C.RodriguesWithJacobian(src.p, dst.p, jacobian.p)
+}
+
+// ProjectPoints projects 3D points to the image plane, given the intrinsic
+// and extrinsic camera parameters.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func ProjectPoints(objectPoints []Point3f, rvec, tvec, cameraMatrix, distCoeffs Mat) []Point2f {
+	pv := Point2fVector{p: C.ProjectPoints(toCPoints3f(objectPoints), rvec.p, tvec.p, cameraMatrix.p, distCoeffs.p)}
+	defer pv.Close()
+
+	return pv.ToPoints()
+}
+
+// ProjectPointsWithJacobian is the same as ProjectPoints, but also computes
+// the Jacobian matrix of derivatives of the image points with respect to the
+// rotation, translation, focal lengths, principal point and distortion
+// coefficients. If aspectRatio is not 0, fx/fy is fixed to it.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func ProjectPointsWithJacobian(objectPoints []Point3f, rvec, tvec, cameraMatrix, distCoeffs Mat, jacobian *Mat, aspectRatio float64) []Point2f {
+	pv := Point2fVector{p: C.ProjectPointsWithJacobian(toCPoints3f(objectPoints), rvec.p, tvec.p, cameraMatrix.p, distCoeffs.p, jacobian.p, C.double(aspectRatio))}
+	defer pv.Close()
+
+	return pv.ToPoints()
+}
+
+// EstimationMethod is the method used to robustly estimate a model, such as a
+// fundamental or essential matrix, from point correspondences.
+//
+// EstimationMethodLMEDS and EstimationMethodRANSAC have the same values as
+// HomograpyMethodLMEDS and HomograpyMethodRANSAC. HomographyMethod is kept as
+// a separate type because FindHomography also accepts 0 (all points), which
+// is not valid here, and does not support the 7-point, 8-point and USAC
+// methods.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+type EstimationMethod int
+
+const (
+	// EstimationMethodFM7Point is the 7-point algorithm, which requires
+	// exactly 7 point pairs. Only used by FindFundamentalMat.
+	EstimationMethodFM7Point EstimationMethod = 1
+
+	// EstimationMethodFM8Point is the 8-point algorithm, which uses all the
+	// point pairs. Only used by FindFundamentalMat.
+	EstimationMethodFM8Point EstimationMethod = 2
+
+	// EstimationMethodLMEDS is the least-median of squares algorithm.
+	EstimationMethodLMEDS EstimationMethod = 4
+
+	// EstimationMethodRANSAC is the RANSAC algorithm.
+	EstimationMethodRANSAC EstimationMethod = 8
+
+	// EstimationMethodUSACDefault is the USAC algorithm with the default settings.
+	EstimationMethodUSACDefault EstimationMethod = 32
+
+	// EstimationMethodUSACParallel is the USAC algorithm running in parallel.
+	EstimationMethodUSACParallel EstimationMethod = 33
+
+	// EstimationMethodUSACFM8Pts is the USAC algorithm using the 8-point
+	// algorithm for the minimal samples. Only used by FindFundamentalMat.
+	EstimationMethodUSACFM8Pts EstimationMethod = 34
+
+	// EstimationMethodUSACFast is the USAC algorithm tuned for speed.
+	EstimationMethodUSACFast EstimationMethod = 35
+
+	// EstimationMethodUSACAccurate is the USAC algorithm tuned for accuracy.
+	EstimationMethodUSACAccurate EstimationMethod = 36
+
+	// EstimationMethodUSACProsac is the USAC algorithm with PROSAC sampling.
+	// The points must be sorted by decreasing quality.
+	EstimationMethodUSACProsac EstimationMethod = 37
+
+	// EstimationMethodUSACMagsac is the USAC algorithm with MAGSAC++ scoring.
+	EstimationMethodUSACMagsac EstimationMethod = 38
+)
+
+// FindFundamentalMat calculates the fundamental matrix from the corresponding
+// points in two images. The points flagged as inliers by the method are set
+// to a non-zero value in mask.
+//
+// With EstimationMethodFM7Point, up to 3 solutions are returned, stacked in a
+// 9x3 matrix.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func FindFundamentalMat(points1, points2 []Point2f, method EstimationMethod, ransacReprojThreshold, confidence float64, maxIters int, mask *Mat) Mat {
+	return newMat(C.FindFundamentalMat(toCPoints2f(points1), toCPoints2f(points2), C.int(method), C.double(ransacReprojThreshold), C.double(confidence),
+		C.int(maxIters), mask.p))
+}
+
+// FindEssentialMat calculates the essential matrix from the corresponding
+// points in two images taken by the same camera. prob is the desired level
+// of confidence and threshold the maximum distance, in pixels, from a point
+// to an epipolar line for the point to be considered an inlier. The inliers
+// are set to a non-zero value in mask.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func FindEssentialMat(points1, points2 []Point2f, cameraMatrix Mat, method EstimationMethod, prob, threshold float64, mask *Mat) Mat {
+	return newMat(C.FindEssentialMat(toCPoints2f(points1), toCPoints2f(points2), cameraMatrix.p, C.int(method), C.double(prob), C.double(threshold), mask.p))
+}
+
+// RecoverPose recovers the relative rotation R and translation t of the
+// second camera from the essential matrix and the corresponding points,
+// using a cheirality check. The translation is only known up to a scale.
+//
+// mask is the inlier mask from FindEssentialMat, and may be empty to use all
+// the points. On return, only the inliers that pass the cheirality check are
+// set in it. The number of those inliers is returned.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func RecoverPose(e Mat, points1, points2 []Point2f, cameraMatrix Mat, r, t, mask *Mat) int {
+	return int(C.RecoverPose(e.p, toCPoints2f(points1), toCPoints2f(points2), cameraMatrix.p, r.p, t.p, mask.p))
+}
+
+// DecomposeEssentialMat decomposes an essential matrix into the two possible
+// rotations r1 and r2, and the translation t, which is only known up to its
+// sign and scale.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func DecomposeEssentialMat(e Mat, r1, r2, t *Mat) {
+	C.DecomposeEssentialMat(e.p, r1.p, r2.p, t.p)
+}
+
+// ComputeCorrespondEpilines computes, for points in one image of a stereo
+// pair, the corresponding epilines in the other image. whichImage is the
+// index, 1 or 2, of the image that contains the points. Each line
+// ax + by + c = 0 is stored as (a, b, c), with a*a + b*b = 1, in a Nx1
+// MatTypeCV32FC3 lines Mat.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func ComputeCorrespondEpilines(points []Point2f, whichImage int, f Mat, lines *Mat) {
+	C.ComputeCorrespondEpilines(toCPoints2f(points), C.int(whichImage), f.p, lines.p)
+}
+
+// CorrectMatches refines the coordinates of corresponding points, so that
+// they minimize the geometric error subject to the epipolar constraint
+// defined by the fundamental matrix f.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func CorrectMatches(f Mat, points1, points2 []Point2f) (newPoints1, newPoints2 []Point2f) {
+	np1 := NewPoint2fVector()
+	defer np1.Close()
+	np2 := NewPoint2fVector()
+	defer np2.Close()
+
+	C.CorrectMatches(f.p, toCPoints2f(points1), toCPoints2f(points2), np1.p, np2.p)
+
+	return np1.ToPoints(), np2.ToPoints()
+}
+
+// SampsonDistance calculates the Sampson distance between two corresponding
+// points, which is the first-order approximation of their geometric error
+// with respect to the fundamental matrix f.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func SampsonDistance(pt1, pt2 Point2f, f Mat) float64 {
+	return float64(C.SampsonDistance(toCPoint2f(pt1), toCPoint2f(pt2), f.p))
+}
+
+// DecomposeHomographyMat decomposes a homography matrix between two views of
+// a plane into up to 4 solutions of rotation, translation and plane normal,
+// given the camera intrinsic matrix k.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func DecomposeHomographyMat(h, k Mat) (rotations, translations, normals []Mat) {
+	cRotations := C.struct_Mats{}
+	cTranslations := C.struct_Mats{}
+	cNormals := C.struct_Mats{}
+	C.DecomposeHomographyMat(h.p, k.p, &cRotations, &cTranslations, &cNormals)
+
+	return toGoMats(cRotations), toGoMats(cTranslations), toGoMats(cNormals)
+}
+
+// FilterHomographyDecompByVisibleRefpoints filters the solutions returned by
+// DecomposeHomographyMat, keeping those for which the reference points are
+// in front of the camera before and after the homography is applied. The
+// indices of the remaining solutions are stored in possibleSolutions.
+// pointsMask optionally flags the points to use, and may be empty.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func FilterHomographyDecompByVisibleRefpoints(rotations, normals []Mat, beforePoints, afterPoints []Point2f, possibleSolutions *Mat, pointsMask Mat) {
+	C.FilterHomographyDecompByVisibleRefpoints(toCMats(rotations), toCMats(normals), toCPoints2f(beforePoints), toCPoints2f(afterPoints),
+		possibleSolutions.p, pointsMask.p)
+}
+
+// DecomposeProjectionMatrix decomposes a 3x4 projection matrix into the
+// camera intrinsic matrix, the rotation matrix and the homogeneous position
+// of the camera.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func DecomposeProjectionMatrix(projMatrix Mat, cameraMatrix, rotMatrix, transVect *Mat) {
+	C.DecomposeProjectionMatrix(projMatrix.p, cameraMatrix.p, rotMatrix.p, transVect.p)
+}
+
+// DecomposeProjectionMatrixWithParams is the same as DecomposeProjectionMatrix,
+// but also returns the rotation matrices around each axis, and the three
+// Euler angles of the rotation in degrees.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func DecomposeProjectionMatrixWithParams(projMatrix Mat, cameraMatrix, rotMatrix, transVect, rotMatrixX, rotMatrixY, rotMatrixZ, eulerAngles *Mat) {
+	C.DecomposeProjectionMatrixWithParams(projMatrix.p, cameraMatrix.p, rotMatrix.p, transVect.p, rotMatrixX.p, rotMatrixY.p, rotMatrixZ.p, eulerAngles.p)
+}
+
+// RQDecomp3x3 computes the RQ decomposition of a 3x3 matrix into the upper
+// triangular matrix mtxR and the orthogonal matrix mtxQ. It returns the three
+// Euler angles of the rotation in degrees.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func RQDecomp3x3(src Mat, mtxR, mtxQ *Mat) Vec3d {
+	var angles [3]C.double
+	C.RQDecomp3x3(src.p, mtxR.p, mtxQ.p, &angles[0])
+
+	return Vec3d{float64(angles[0]), float64(angles[1]), float64(angles[2])}
+}
+
+// RQDecomp3x3WithParams is the same as RQDecomp3x3, but also returns the
+// rotation matrices around each axis in qx, qy and qz.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d9/d0c/group__calib3d.html
+//
+func RQDecomp3x3WithParams(src Mat, mtxR, mtxQ, qx, qy, qz *Mat) Vec3d {
+	var angles [3]C.double
+	C.RQDecomp3x3WithParams(src.p, mtxR.p, mtxQ.p, qx.p, qy.p, qz.p, &angles[0])
+
+	return Vec3d{float64(angles[0]), float64(angles[1]), float64(angles[2])}
+}
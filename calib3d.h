@@ -18,12 +18,46 @@ void Fisheye_UndistortPoints(Mat distorted, Mat undistorted, Mat k, Mat d, Mat R
 void Fisheye_EstimateNewCameraMatrixForUndistortRectify(Mat k, Mat d, Size imgSize, Mat r, Mat p, double balance, Size newSize, double fovScale);
 
 void InitUndistortRectifyMap(Mat cameraMatrix,Mat distCoeffs,Mat r,Mat newCameraMatrix,Size size,int m1type,Mat map1,Mat map2);
+float InitWideAngleProjMap(Mat cameraMatrix, Mat distCoeffs, Size imageSize, int destImageWidth, int m1type, Mat map1, Mat map2, int projType, double alpha);
 Mat GetOptimalNewCameraMatrixWithParams(Mat cameraMatrix,Mat distCoeffs,Size size,double alpha,Size newImgSize,Rect* validPixROI,bool centerPrincipalPoint);
 void Undistort(Mat src, Mat dst, Mat cameraMatrix, Mat distCoeffs, Mat newCameraMatrix);
 void UndistortPoints(Mat distorted, Mat undistorted, Mat k, Mat d, Mat r, Mat p);
 bool FindChessboardCorners(Mat image, Size patternSize, Mat corners, int flags);
 void DrawChessboardCorners(Mat image, Size patternSize, Mat corners, bool patternWasFound);
+double CalibrateCamera(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, int flags, TermCriteria criteria);
+double CalibrateCameraExtended(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, Mat stdDevsIntrinsics, Mat stdDevsExtrinsics, Mat perViewErrors, int flags, TermCriteria criteria);
+double CalibrateCameraRO(struct Mats objectPoints, struct Mats imagePoints, Size imageSize, int iFixedPoint, Mat cameraMatrix, Mat distCoeffs, Mat rvecs, Mat tvecs, Mat newObjPoints, int flags, TermCriteria criteria);
+void CalibrationMatrixValues(Mat cameraMatrix, Size imageSize, double apertureWidth, double apertureHeight, double* fovx, double* fovy, double* focalLength, Point2f* principalPoint, double* aspectRatio);
 Mat EstimateAffinePartial2D(Point2fVector from, Point2fVector to);
+Mat EstimateAffine2D(Points2f from, Points2f to);
+Mat EstimateAffine2DWithParams(Points2f from, Points2f to, Mat inliers, int method, double ransacReprojThreshold, int maxIters, double confidence, int refineIters);
+int EstimateAffine3D(Points3f src, Points3f dst, Mat out, Mat inliers, double ransacThreshold, double confidence);
+
+bool SolvePnP(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, bool useExtrinsicGuess, int flags);
+bool SolvePnPRansac(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, bool useExtrinsicGuess, int iterationsCount, float reprojectionError, double confidence, Mat inliers, int flags);
+void SolvePnPGeneric(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, struct Mats* rvecs, struct Mats* tvecs, bool useExtrinsicGuess, int flags, Mat rvec, Mat tvec, Mat reprojectionError);
+void SolvePnPRefineLM(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, TermCriteria criteria);
+void SolvePnPRefineVVS(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, Mat rvec, Mat tvec, TermCriteria criteria, double VVSlambda);
+void SolveP3P(Points3f objectPoints, Points2f imagePoints, Mat cameraMatrix, Mat distCoeffs, struct Mats* rvecs, struct Mats* tvecs, int flags);
+void Rodrigues(Mat src, Mat dst);
+void RodriguesWithJacobian(Mat src, Mat dst, Mat jacobian);
+Point2fVector ProjectPoints(Points3f objectPoints, Mat rvec, Mat tvec, Mat cameraMatrix, Mat distCoeffs);
+Point2fVector ProjectPointsWithJacobian(Points3f objectPoints, Mat rvec, Mat tvec, Mat cameraMatrix, Mat distCoeffs, Mat jacobian, double aspectRatio);
+
+Mat FindFundamentalMat(Points2f points1, Points2f points2, int method, double ransacReprojThreshold, double confidence, int maxIters, Mat mask);
+Mat FindEssentialMat(Points2f points1, Points2f points2, Mat cameraMatrix, int method, double prob, double threshold, Mat mask);
+int RecoverPose(Mat E, Points2f points1, Points2f points2, Mat cameraMatrix, Mat R, Mat t, Mat mask);
+void DecomposeEssentialMat(Mat E, Mat R1, Mat R2, Mat t);
+void ComputeCorrespondEpilines(Points2f points, int whichImage, Mat F, Mat lines);
+void CorrectMatches(Mat F, Points2f points1, Points2f points2, Point2fVector newPoints1, Point2fVector newPoints2);
+double SampsonDistance(Point2f pt1, Point2f pt2, Mat F);
+
+void DecomposeHomographyMat(Mat H, Mat K, struct Mats* rotations, struct Mats* translations, struct Mats* normals);
+void FilterHomographyDecompByVisibleRefpoints(struct Mats rotations, struct Mats normals, Points2f beforePoints, Points2f afterPoints, Mat possibleSolutions, Mat pointsMask);
+void DecomposeProjectionMatrix(Mat projMatrix, Mat cameraMatrix, Mat rotMatrix, Mat transVect);
+void DecomposeProjectionMatrixWithParams(Mat projMatrix, Mat cameraMatrix, Mat rotMatrix, Mat transVect, Mat rotMatrixX, Mat rotMatrixY, Mat rotMatrixZ, Mat eulerAngles);
+void RQDecomp3x3(Mat src, Mat mtxR, Mat mtxQ, double* eulerAngles);
+void RQDecomp3x3WithParams(Mat src, Mat mtxR, Mat mtxQ, Mat qx, Mat qy, Mat qz, double* eulerAngles);
 #ifdef __cplusplus
 }
 #endif
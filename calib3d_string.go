@@ -26,6 +26,48 @@ func (c CalibFlag) String() string {
 	return ""
 }
 
+func (c CalibCameraFlag) String() string {
+	switch c {
+	case CalibCameraUseIntrinsicGuess:
+		return "calib-camera-use-intrinsic-guess"
+	case CalibCameraFixAspectRatio:
+		return "calib-camera-fix-aspect-ratio"
+	case CalibCameraFixPrincipalPoint:
+		return "calib-camera-fix-principal-point"
+	case CalibCameraZeroTangentDist:
+		return "calib-camera-zero-tangent-dist"
+	case CalibCameraFixFocalLength:
+		return "calib-camera-fix-focal-length"
+	case CalibCameraFixK1:
+		return "calib-camera-fix-k1"
+	case CalibCameraFixK2:
+		return "calib-camera-fix-k2"
+	case CalibCameraFixK3:
+		return "calib-camera-fix-k3"
+	case CalibCameraFixIntrinsic:
+		return "calib-camera-fix-intrinsic"
+	case CalibCameraFixK4:
+		return "calib-camera-fix-k4"
+	case CalibCameraFixK5:
+		return "calib-camera-fix-k5"
+	case CalibCameraFixK6:
+		return "calib-camera-fix-k6"
+	case CalibCameraRationalModel:
+		return "calib-camera-rational-model"
+	case CalibCameraThinPrismModel:
+		return "calib-camera-thin-prism-model"
+	case CalibCameraFixS1S2S3S4:
+		return "calib-camera-fix-s1-s2-s3-s4"
+	case CalibCameraTiltedModel:
+		return "calib-camera-tilted-model"
+	case CalibCameraFixTauxTauy:
+		return "calib-camera-fix-taux-tauy"
+	case CalibCameraFixTangentDist:
+		return "calib-camera-fix-tangent-dist"
+	}
+	return ""
+}
+
 func (c CalibCBFlag) String() string {
 	switch c {
 	case CalibCBAdaptiveThresh:
@@ -47,3 +89,63 @@ func (c CalibCBFlag) String() string {
 	}
 	return ""
 }
+
+func (c UndistortTypes) String() string {
+	switch c {
+	case ProjSphericalOrtho:
+		return "proj-spherical-ortho"
+	case ProjSphericalEqrect:
+		return "proj-spherical-eqrect"
+	}
+	return ""
+}
+
+func (c SolvePnPMethod) String() string {
+	switch c {
+	case SolvePnPIterative:
+		return "solve-pnp-iterative"
+	case SolvePnPEPnP:
+		return "solve-pnp-epnp"
+	case SolvePnPP3P:
+		return "solve-pnp-p3p"
+	case SolvePnPDLS:
+		return "solve-pnp-dls"
+	case SolvePnPUPnP:
+		return "solve-pnp-upnp"
+	case SolvePnPAP3P:
+		return "solve-pnp-ap3p"
+	case SolvePnPIPPE:
+		return "solve-pnp-ippe"
+	case SolvePnPIPPESquare:
+		return "solve-pnp-ippe-square"
+	}
+	return ""
+}
+
+func (c EstimationMethod) String() string {
+	switch c {
+	case EstimationMethodFM7Point:
+		return "estimation-method-fm-7-point"
+	case EstimationMethodFM8Point:
+		return "estimation-method-fm-8-point"
+	case EstimationMethodLMEDS:
+		return "estimation-method-lmeds"
+	case EstimationMethodRANSAC:
+		return "estimation-method-ransac"
+	case EstimationMethodUSACDefault:
+		return "estimation-method-usac-default"
+	case EstimationMethodUSACParallel:
+		return "estimation-method-usac-parallel"
+	case EstimationMethodUSACFM8Pts:
+		return "estimation-method-usac-fm-8-pts"
+	case EstimationMethodUSACFast:
+		return "estimation-method-usac-fast"
+	case EstimationMethodUSACAccurate:
+		return "estimation-method-usac-accurate"
+	case EstimationMethodUSACProsac:
+		return "estimation-method-usac-prosac"
+	case EstimationMethodUSACMagsac:
+		return "estimation-method-usac-magsac"
+	}
+	return ""
+}
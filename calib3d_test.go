@@ -162,6 +162,36 @@ func TestInitUndistortRectifyMap(t *testing.T) {
 	}
 }
 
+func TestInitWideAngleProjMap(t *testing.T) {
+	k := NewMatWithSize(3, 3, MatTypeCV64F)
+	defer k.Close()
+	k.SetDoubleAt(0, 0, 300)
+	k.SetDoubleAt(0, 2, 320)
+	k.SetDoubleAt(1, 1, 300)
+	k.SetDoubleAt(1, 2, 240)
+	k.SetDoubleAt(2, 2, 1)
+
+	d := NewMatWithSize(1, 5, MatTypeCV64F)
+	defer d.Close()
+	d.SetDoubleAt(0, 0, -0.2)
+
+	map1 := NewMat()
+	defer map1.Close()
+	map2 := NewMat()
+	defer map2.Close()
+
+	scale := InitWideAngleProjMap(k, d, image.Pt(640, 480), 320, MatTypeCV16SC2, &map1, &map2, ProjSphericalEqrect, 0)
+	if scale <= 0 {
+		t.Errorf("Invalid InitWideAngleProjMap test scale: %v", scale)
+	}
+	if map1.Type() != MatTypeCV16SC2 || map1.Cols() != 320 || map1.Rows() == 0 {
+		t.Errorf("Invalid InitWideAngleProjMap test map1: %vx%v %v", map1.Rows(), map1.Cols(), map1.Type())
+	}
+	if map2.Rows() != map1.Rows() || map2.Cols() != map1.Cols() {
+		t.Errorf("Invalid InitWideAngleProjMap test map2: %vx%v", map2.Rows(), map2.Cols())
+	}
+}
+
 func TestUndistort(t *testing.T) {
 	img := IMRead("images/distortion.jpg", IMReadUnchanged)
 	if img.Empty() {
@@ -383,6 +413,154 @@ func TestFindAndDrawChessboard(t *testing.T) {
 	}
 }
 
+// calibrationViews projects a planar 7x5 grid with a pinhole camera with
+// fx = fy = 500 and principal point (320, 240) from a few viewpoints, and
+// returns the object and image points of each view.
+func calibrationViews() (objectPoints, imagePoints []Mat) {
+	const cols, rows = 7, 5
+	views := [][2]float64{{0.2, 0}, {-0.2, 0.1}, {0.1, -0.25}, {0.3, 0.3}}
+
+	for _, v := range views {
+		sa, ca := math.Sin(v[0]), math.Cos(v[0])
+		sb, cb := math.Sin(v[1]), math.Cos(v[1])
+
+		obj := NewMatWithSize(cols*rows, 3, MatTypeCV32F)
+		img := NewMatWithSize(cols*rows, 2, MatTypeCV32F)
+		for i := 0; i < cols*rows; i++ {
+			x, y := float64(i%cols), float64(i/cols)
+			obj.SetFloatAt(i, 0, float32(x))
+			obj.SetFloatAt(i, 1, float32(y))
+			obj.SetFloatAt(i, 2, 0)
+
+			// rotate around the x axis, then around the y axis, then move the
+			// pattern in front of the camera.
+			y1, z1 := ca*y, sa*y
+			xc := cb*x + sb*z1 - 3
+			yc := y1 - 2
+			zc := -sb*x + cb*z1 + 12
+
+			img.SetFloatAt(i, 0, float32(500*xc/zc+320))
+			img.SetFloatAt(i, 1, float32(500*yc/zc+240))
+		}
+		objectPoints = append(objectPoints, obj)
+		imagePoints = append(imagePoints, img)
+	}
+	return
+}
+
+func closeMats(mats []Mat) {
+	for _, m := range mats {
+		m.Close()
+	}
+}
+
+func TestCalibrateCamera(t *testing.T) {
+	objectPoints, imagePoints := calibrationViews()
+	defer closeMats(objectPoints)
+	defer closeMats(imagePoints)
+
+	cameraMatrix := NewMat()
+	defer cameraMatrix.Close()
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+	rvecs := NewMat()
+	defer rvecs.Close()
+	tvecs := NewMat()
+	defer tvecs.Close()
+
+	criteria := NewTermCriteria(Count|EPS, 100, 1e-10)
+	rms := CalibrateCamera(objectPoints, imagePoints, image.Pt(640, 480), &cameraMatrix, &distCoeffs, &rvecs, &tvecs, 0, criteria)
+	if rms > 0.1 {
+		t.Errorf("TestCalibrateCamera(): re-projection error too large: %v", rms)
+	}
+	if cameraMatrix.Rows() != 3 || cameraMatrix.Cols() != 3 {
+		t.Fatalf("TestCalibrateCamera(): invalid camera matrix size %v", cameraMatrix.Size())
+	}
+	if fx := cameraMatrix.GetDoubleAt(0, 0); math.Abs(fx-500) > 5 {
+		t.Errorf("TestCalibrateCamera(): wrong fx = %v, want = %v", fx, 500)
+	}
+	if cx := cameraMatrix.GetDoubleAt(0, 2); math.Abs(cx-320) > 5 {
+		t.Errorf("TestCalibrateCamera(): wrong cx = %v, want = %v", cx, 320)
+	}
+	if rvecs.Rows() != len(objectPoints) || tvecs.Rows() != len(objectPoints) {
+		t.Errorf("TestCalibrateCamera(): expected %d rvecs and tvecs, got %d and %d", len(objectPoints), rvecs.Rows(), tvecs.Rows())
+	}
+
+	stdDevsIntrinsics := NewMat()
+	defer stdDevsIntrinsics.Close()
+	stdDevsExtrinsics := NewMat()
+	defer stdDevsExtrinsics.Close()
+	perViewErrors := NewMat()
+	defer perViewErrors.Close()
+
+	rms = CalibrateCameraExtended(objectPoints, imagePoints, image.Pt(640, 480), &cameraMatrix, &distCoeffs, &rvecs, &tvecs,
+		&stdDevsIntrinsics, &stdDevsExtrinsics, &perViewErrors, CalibCameraZeroTangentDist, criteria)
+	if rms > 0.1 {
+		t.Errorf("TestCalibrateCameraExtended(): re-projection error too large: %v", rms)
+	}
+	if stdDevsIntrinsics.Empty() || stdDevsExtrinsics.Empty() {
+		t.Error("TestCalibrateCameraExtended(): expected standard deviations")
+	}
+	if perViewErrors.Total() != len(objectPoints) {
+		t.Errorf("TestCalibrateCameraExtended(): expected %d per view errors, got %d", len(objectPoints), perViewErrors.Total())
+	}
+}
+
+func TestCalibrateCameraRO(t *testing.T) {
+	objectPoints, imagePoints := calibrationViews()
+	defer closeMats(objectPoints)
+	defer closeMats(imagePoints)
+
+	cameraMatrix := NewMat()
+	defer cameraMatrix.Close()
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+	rvecs := NewMat()
+	defer rvecs.Close()
+	tvecs := NewMat()
+	defer tvecs.Close()
+	newObjPoints := NewMat()
+	defer newObjPoints.Close()
+
+	criteria := NewTermCriteria(Count|EPS, 100, 1e-10)
+	rms := CalibrateCameraRO(objectPoints, imagePoints, image.Pt(640, 480), 6, &cameraMatrix, &distCoeffs, &rvecs, &tvecs,
+		&newObjPoints, 0, criteria)
+	if rms > 0.1 {
+		t.Errorf("TestCalibrateCameraRO(): re-projection error too large: %v", rms)
+	}
+	if newObjPoints.Total() != objectPoints[0].Rows() {
+		t.Errorf("TestCalibrateCameraRO(): expected %d new object points, got %d", objectPoints[0].Rows(), newObjPoints.Total())
+	}
+}
+
+func TestCalibrationMatrixValues(t *testing.T) {
+	cameraMatrix := NewMatWithSize(3, 3, MatTypeCV64F)
+	defer cameraMatrix.Close()
+	cameraMatrix.SetDoubleAt(0, 0, 800)
+	cameraMatrix.SetDoubleAt(0, 2, 320)
+	cameraMatrix.SetDoubleAt(1, 1, 800)
+	cameraMatrix.SetDoubleAt(1, 2, 240)
+	cameraMatrix.SetDoubleAt(2, 2, 1)
+
+	fovx, fovy, focalLength, principalPoint, aspectRatio := CalibrationMatrixValues(cameraMatrix, image.Pt(640, 480), 0, 0)
+
+	if want := 2 * math.Atan(320.0/800) * 180 / math.Pi; math.Abs(fovx-want) > 1e-6 {
+		t.Errorf("TestCalibrationMatrixValues(): wrong fovx = %v, want = %v", fovx, want)
+	}
+	if want := 2 * math.Atan(240.0/800) * 180 / math.Pi; math.Abs(fovy-want) > 1e-6 {
+		t.Errorf("TestCalibrationMatrixValues(): wrong fovy = %v, want = %v", fovy, want)
+	}
+	if focalLength != 800 {
+		t.Errorf("TestCalibrationMatrixValues(): wrong focal length = %v, want = %v", focalLength, 800)
+	}
+	if principalPoint != (Point2f{320, 240}) {
+		t.Errorf("TestCalibrationMatrixValues(): wrong principal point = %v", principalPoint)
+	}
+	if aspectRatio != 1 {
+		t.Errorf("TestCalibrationMatrixValues(): wrong aspect ratio = %v, want = %v", aspectRatio, 1)
+	}
+}
+
 func TestEstimateAffinePartial2D(t *testing.T) {
 	src := []Point2f{
 		{0, 0},
@@ -414,3 +592,651 @@ func TestEstimateAffinePartial2D(t *testing.T) {
 		t.Errorf("TestEstimateAffinePartial2D(): unexpected rows = %v, want = %v", m.Rows(), 2)
 	}
 }
+
+// pnpScene returns a camera matrix with fx = fy = 800 and principal point
+// (320, 240), a known object pose, and a few non-coplanar object points.
+func pnpScene() (cameraMatrix, rvec, tvec Mat, objectPoints []Point3f) {
+	cameraMatrix = NewMatWithSize(3, 3, MatTypeCV64F)
+	cameraMatrix.SetDoubleAt(0, 0, 800)
+	cameraMatrix.SetDoubleAt(0, 2, 320)
+	cameraMatrix.SetDoubleAt(1, 1, 800)
+	cameraMatrix.SetDoubleAt(1, 2, 240)
+	cameraMatrix.SetDoubleAt(2, 2, 1)
+
+	rvec = newVec3d(0.1, -0.2, 0.05)
+	tvec = newVec3d(0.5, -0.3, 10)
+
+	objectPoints = []Point3f{
+		{0, 0, 0},
+		{1, 0, 0},
+		{0, 1, 0},
+		{1, 1, 0.5},
+		{-1, 0.5, 1},
+		{0.5, -1, -0.5},
+		{-0.5, -0.5, 0.25},
+		{1.5, 1, -1},
+	}
+	return
+}
+
+func newVec3d(x, y, z float64) Mat {
+	v := NewMatWithSize(3, 1, MatTypeCV64F)
+	v.SetDoubleAt(0, 0, x)
+	v.SetDoubleAt(1, 0, y)
+	v.SetDoubleAt(2, 0, z)
+	return v
+}
+
+func vec3dNear(v Mat, x, y, z, tolerance float64) bool {
+	return math.Abs(v.GetDoubleAt(0, 0)-x) < tolerance &&
+		math.Abs(v.GetDoubleAt(1, 0)-y) < tolerance &&
+		math.Abs(v.GetDoubleAt(2, 0)-z) < tolerance
+}
+
+func TestProjectPoints(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+	if len(imagePoints) != len(objectPoints) {
+		t.Fatalf("TestProjectPoints(): expected %d points, got %d", len(objectPoints), len(imagePoints))
+	}
+
+	// the first object point is the origin, so it projects to the translation.
+	want := Point2f{X: 800*0.5/10 + 320, Y: 800*-0.3/10 + 240}
+	if math.Abs(float64(imagePoints[0].X-want.X)) > 1e-3 || math.Abs(float64(imagePoints[0].Y-want.Y)) > 1e-3 {
+		t.Errorf("TestProjectPoints(): wrong point = %v, want = %v", imagePoints[0], want)
+	}
+
+	jacobian := NewMat()
+	defer jacobian.Close()
+
+	imagePoints = ProjectPointsWithJacobian(objectPoints, rvec, tvec, cameraMatrix, distCoeffs, &jacobian, 0)
+	if len(imagePoints) != len(objectPoints) {
+		t.Fatalf("TestProjectPointsWithJacobian(): expected %d points, got %d", len(objectPoints), len(imagePoints))
+	}
+	if jacobian.Rows() != 2*len(objectPoints) {
+		t.Errorf("TestProjectPointsWithJacobian(): expected %d jacobian rows, got %d", 2*len(objectPoints), jacobian.Rows())
+	}
+}
+
+func TestRodrigues(t *testing.T) {
+	rvec := newVec3d(0, 0, math.Pi/2)
+	defer rvec.Close()
+
+	rmat := NewMat()
+	defer rmat.Close()
+
+	Rodrigues(rvec, &rmat)
+	if rmat.Rows() != 3 || rmat.Cols() != 3 {
+		t.Fatalf("TestRodrigues(): invalid rotation matrix size %v", rmat.Size())
+	}
+	if v := rmat.GetDoubleAt(1, 0); math.Abs(v-1) > 1e-9 {
+		t.Errorf("TestRodrigues(): wrong rotation matrix value = %v, want = %v", v, 1)
+	}
+
+	back := NewMat()
+	defer back.Close()
+	jacobian := NewMat()
+	defer jacobian.Close()
+
+	RodriguesWithJacobian(rmat, &back, &jacobian)
+	if !vec3dNear(back, 0, 0, math.Pi/2, 1e-9) {
+		t.Errorf("TestRodrigues(): rotation vector did not round trip")
+	}
+	if jacobian.Rows() != 9 || jacobian.Cols() != 3 {
+		t.Errorf("TestRodriguesWithJacobian(): invalid jacobian size %v", jacobian.Size())
+	}
+}
+
+func TestSolvePnP(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+
+	for _, method := range []SolvePnPMethod{SolvePnPIterative, SolvePnPEPnP} {
+		r := NewMat()
+		tv := NewMat()
+
+		if !SolvePnP(objectPoints, imagePoints, cameraMatrix, distCoeffs, &r, &tv, false, method) {
+			t.Errorf("TestSolvePnP(%v): no solution found", method)
+		}
+		if !vec3dNear(r, 0.1, -0.2, 0.05, 1e-3) || !vec3dNear(tv, 0.5, -0.3, 10, 1e-2) {
+			t.Errorf("TestSolvePnP(%v): wrong pose", method)
+		}
+
+		r.Close()
+		tv.Close()
+	}
+}
+
+func TestSolvePnPRansac(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+
+	// add an outlier.
+	objectPoints = append(objectPoints, Point3f{2, 2, 2})
+	imagePoints = append(imagePoints, Point2f{10, 10})
+
+	r := NewMat()
+	defer r.Close()
+	tv := NewMat()
+	defer tv.Close()
+	inliers := NewMat()
+	defer inliers.Close()
+
+	if !SolvePnPRansac(objectPoints, imagePoints, cameraMatrix, distCoeffs, &r, &tv, false, 100, 2, 0.99, &inliers, SolvePnPEPnP) {
+		t.Fatal("TestSolvePnPRansac(): no solution found")
+	}
+	if inliers.Total() != len(objectPoints)-1 {
+		t.Errorf("TestSolvePnPRansac(): expected %d inliers, got %d", len(objectPoints)-1, inliers.Total())
+	}
+	if !vec3dNear(tv, 0.5, -0.3, 10, 1e-2) {
+		t.Error("TestSolvePnPRansac(): wrong pose")
+	}
+}
+
+func TestSolvePnPGeneric(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+
+	empty := NewMat()
+	defer empty.Close()
+	reprojectionError := NewMat()
+	defer reprojectionError.Close()
+
+	rvecs, tvecs := SolvePnPGeneric(objectPoints, imagePoints, cameraMatrix, distCoeffs, false, SolvePnPIterative, empty, empty, &reprojectionError)
+	defer closeMats(rvecs)
+	defer closeMats(tvecs)
+
+	if len(rvecs) != 1 || len(tvecs) != 1 {
+		t.Fatalf("TestSolvePnPGeneric(): expected 1 solution, got %d and %d", len(rvecs), len(tvecs))
+	}
+	if !vec3dNear(tvecs[0], 0.5, -0.3, 10, 1e-2) {
+		t.Error("TestSolvePnPGeneric(): wrong pose")
+	}
+	if reprojectionError.Total() != 1 {
+		t.Errorf("TestSolvePnPGeneric(): expected 1 re-projection error, got %d", reprojectionError.Total())
+	}
+}
+
+func TestSolvePnPRefine(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+	criteria := NewTermCriteria(Count|EPS, 50, 1e-10)
+
+	r := newVec3d(0.12, -0.18, 0.04)
+	defer r.Close()
+	tv := newVec3d(0.4, -0.2, 9.5)
+	defer tv.Close()
+
+	SolvePnPRefineLM(objectPoints, imagePoints, cameraMatrix, distCoeffs, &r, &tv, criteria)
+	if !vec3dNear(r, 0.1, -0.2, 0.05, 1e-3) || !vec3dNear(tv, 0.5, -0.3, 10, 1e-2) {
+		t.Error("TestSolvePnPRefineLM(): pose not refined")
+	}
+
+	r2 := newVec3d(0.12, -0.18, 0.04)
+	defer r2.Close()
+	tv2 := newVec3d(0.4, -0.2, 9.5)
+	defer tv2.Close()
+
+	SolvePnPRefineVVS(objectPoints, imagePoints, cameraMatrix, distCoeffs, &r2, &tv2, criteria, 1)
+	if !vec3dNear(r2, 0.1, -0.2, 0.05, 1e-3) || !vec3dNear(tv2, 0.5, -0.3, 10, 1e-2) {
+		t.Error("TestSolvePnPRefineVVS(): pose not refined")
+	}
+}
+
+func TestSolveP3P(t *testing.T) {
+	cameraMatrix, rvec, tvec, objectPoints := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	objectPoints = objectPoints[:3]
+	imagePoints := ProjectPoints(objectPoints, rvec, tvec, cameraMatrix, distCoeffs)
+
+	rvecs, tvecs := SolveP3P(objectPoints, imagePoints, cameraMatrix, distCoeffs, SolvePnPP3P)
+	defer closeMats(rvecs)
+	defer closeMats(tvecs)
+
+	if len(rvecs) == 0 || len(rvecs) != len(tvecs) {
+		t.Fatalf("TestSolveP3P(): invalid number of solutions %d and %d", len(rvecs), len(tvecs))
+	}
+
+	found := false
+	for i := range tvecs {
+		if vec3dNear(rvecs[i], 0.1, -0.2, 0.05, 1e-3) && vec3dNear(tvecs[i], 0.5, -0.3, 10, 1e-2) {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("TestSolveP3P(): expected pose not among the solutions")
+	}
+}
+
+// twoViewScene projects a set of 3D points with the pnpScene camera from the
+// origin, and from a second camera rotated around the y axis and moved along
+// the x axis.
+func twoViewScene() (cameraMatrix Mat, points1, points2 []Point2f) {
+	var objectPoints []Point3f
+	for _, z := range []float32{8, 10} {
+		for x := float32(-1.5); x <= 1.5; x++ {
+			for y := float32(-1.5); y <= 1.5; y++ {
+				objectPoints = append(objectPoints, Point3f{x, y + x*0.1, z + y*0.2})
+			}
+		}
+	}
+
+	cameraMatrix, rvec, tvec, _ := pnpScene()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	rvec1 := newVec3d(0, 0, 0)
+	defer rvec1.Close()
+	tvec1 := newVec3d(0, 0, 0)
+	defer tvec1.Close()
+	points1 = ProjectPoints(objectPoints, rvec1, tvec1, cameraMatrix, distCoeffs)
+
+	rvec2 := newVec3d(0, 0.1, 0)
+	defer rvec2.Close()
+	tvec2 := newVec3d(-1, 0, 0)
+	defer tvec2.Close()
+	points2 = ProjectPoints(objectPoints, rvec2, tvec2, cameraMatrix, distCoeffs)
+
+	return
+}
+
+func TestFindFundamentalMat(t *testing.T) {
+	cameraMatrix, points1, points2 := twoViewScene()
+	defer cameraMatrix.Close()
+
+	mask := NewMat()
+	defer mask.Close()
+
+	f := FindFundamentalMat(points1, points2, EstimationMethodFM8Point, 3, 0.99, 1000, &mask)
+	defer f.Close()
+
+	if f.Rows() != 3 || f.Cols() != 3 {
+		t.Fatalf("TestFindFundamentalMat(): invalid fundamental matrix size %v", f.Size())
+	}
+
+	for i := range points1 {
+		if d := SampsonDistance(points1[i], points2[i], f); d > 1e-2 {
+			t.Errorf("TestSampsonDistance(): point %d does not satisfy the epipolar constraint: %v", i, d)
+		}
+	}
+
+	lines := NewMat()
+	defer lines.Close()
+
+	ComputeCorrespondEpilines(points1, 1, f, &lines)
+	if lines.Rows() != len(points1) {
+		t.Fatalf("TestComputeCorrespondEpilines(): expected %d lines, got %d", len(points1), lines.Rows())
+	}
+	for i, pt := range points2 {
+		l := lines.GetVecfAt(i, 0)
+		if d := l[0]*pt.X + l[1]*pt.Y + l[2]; math.Abs(float64(d)) > 0.1 {
+			t.Errorf("TestComputeCorrespondEpilines(): point %d is %v away from its epiline", i, d)
+		}
+	}
+
+	newPoints1, newPoints2 := CorrectMatches(f, points1, points2)
+	if len(newPoints1) != len(points1) || len(newPoints2) != len(points2) {
+		t.Fatalf("TestCorrectMatches(): expected %d points, got %d and %d", len(points1), len(newPoints1), len(newPoints2))
+	}
+	for i := range points1 {
+		if math.Abs(float64(newPoints1[i].X-points1[i].X)) > 0.1 || math.Abs(float64(newPoints2[i].Y-points2[i].Y)) > 0.1 {
+			t.Errorf("TestCorrectMatches(): point %d moved too far: %v %v", i, newPoints1[i], newPoints2[i])
+		}
+	}
+
+	ransacMask := NewMat()
+	defer ransacMask.Close()
+
+	f2 := FindFundamentalMat(points1, points2, EstimationMethodRANSAC, 1, 0.99, 1000, &ransacMask)
+	defer f2.Close()
+
+	if f2.Empty() {
+		t.Error("TestFindFundamentalMat(): RANSAC found no fundamental matrix")
+	}
+	if n := CountNonZero(ransacMask); n != len(points1) {
+		t.Errorf("TestFindFundamentalMat(): expected %d inliers, got %d", len(points1), n)
+	}
+}
+
+func TestFindEssentialMatAndRecoverPose(t *testing.T) {
+	cameraMatrix, points1, points2 := twoViewScene()
+	defer cameraMatrix.Close()
+
+	mask := NewMat()
+	defer mask.Close()
+
+	e := FindEssentialMat(points1, points2, cameraMatrix, EstimationMethodRANSAC, 0.999, 1, &mask)
+	defer e.Close()
+
+	if e.Rows() != 3 || e.Cols() != 3 {
+		t.Fatalf("TestFindEssentialMat(): invalid essential matrix size %v", e.Size())
+	}
+
+	r := NewMat()
+	defer r.Close()
+	tv := NewMat()
+	defer tv.Close()
+
+	if n := RecoverPose(e, points1, points2, cameraMatrix, &r, &tv, &mask); n != len(points1) {
+		t.Errorf("TestRecoverPose(): expected %d inliers, got %d", len(points1), n)
+	}
+	if !vec3dNear(tv, -1, 0, 0, 0.05) {
+		t.Errorf("TestRecoverPose(): wrong translation %v %v %v", tv.GetDoubleAt(0, 0), tv.GetDoubleAt(1, 0), tv.GetDoubleAt(2, 0))
+	}
+
+	rvec := NewMat()
+	defer rvec.Close()
+
+	Rodrigues(r, &rvec)
+	if !vec3dNear(rvec, 0, 0.1, 0, 1e-2) {
+		t.Error("TestRecoverPose(): wrong rotation")
+	}
+
+	r1 := NewMat()
+	defer r1.Close()
+	r2 := NewMat()
+	defer r2.Close()
+	t1 := NewMat()
+	defer t1.Close()
+
+	DecomposeEssentialMat(e, &r1, &r2, &t1)
+	if r1.Rows() != 3 || r1.Cols() != 3 || r2.Rows() != 3 || r2.Cols() != 3 {
+		t.Errorf("TestDecomposeEssentialMat(): invalid rotation sizes %v %v", r1.Size(), r2.Size())
+	}
+	if t1.Rows() != 3 || t1.Cols() != 1 {
+		t.Errorf("TestDecomposeEssentialMat(): invalid translation size %v", t1.Size())
+	}
+}
+
+func TestEstimateAffine2D(t *testing.T) {
+	var src, dst []Point2f
+	for x := float32(0); x < 4; x++ {
+		for y := float32(0); y < 3; y++ {
+			src = append(src, Point2f{x * 10, y * 10})
+			dst = append(dst, Point2f{2*x*10 + 0.5*y*10 + 3, -0.3*x*10 + 1.5*y*10 - 2})
+		}
+	}
+
+	m := EstimateAffine2D(src, dst)
+	defer m.Close()
+
+	if m.Rows() != 2 || m.Cols() != 3 {
+		t.Fatalf("TestEstimateAffine2D(): invalid transform size %v", m.Size())
+	}
+	if v := m.GetDoubleAt(0, 0); math.Abs(v-2) > 1e-3 {
+		t.Errorf("TestEstimateAffine2D(): wrong value = %v, want = %v", v, 2)
+	}
+	if v := m.GetDoubleAt(1, 2); math.Abs(v+2) > 1e-3 {
+		t.Errorf("TestEstimateAffine2D(): wrong value = %v, want = %v", v, -2)
+	}
+
+	// add an outlier.
+	src2 := append(src, Point2f{15, 15})
+	dst2 := append(dst, Point2f{-100, 100})
+
+	inliers := NewMat()
+	defer inliers.Close()
+
+	m2 := EstimateAffine2DWithParams(src2, dst2, &inliers, EstimationMethodLMEDS, 3, 2000, 0.99, 10)
+	defer m2.Close()
+
+	if v := m2.GetDoubleAt(1, 1); math.Abs(v-1.5) > 1e-3 {
+		t.Errorf("TestEstimateAffine2DWithParams(): wrong value = %v, want = %v", v, 1.5)
+	}
+	if n := CountNonZero(inliers); n != len(src) {
+		t.Errorf("TestEstimateAffine2DWithParams(): expected %d inliers, got %d", len(src), n)
+	}
+}
+
+func TestEstimateAffine3D(t *testing.T) {
+	cameraMatrix, rvec, tvec, src := pnpScene()
+	defer cameraMatrix.Close()
+	defer rvec.Close()
+	defer tvec.Close()
+
+	dst := make([]Point3f, len(src))
+	for i, p := range src {
+		dst[i] = Point3f{2*p.X + 1, p.Y - p.Z, 0.5*p.Z + 3}
+	}
+
+	out := NewMat()
+	defer out.Close()
+	inliers := NewMat()
+	defer inliers.Close()
+
+	if !EstimateAffine3D(src, dst, &out, &inliers, 1, 0.99) {
+		t.Fatal("TestEstimateAffine3D(): no transformation found")
+	}
+	if out.Rows() != 3 || out.Cols() != 4 {
+		t.Fatalf("TestEstimateAffine3D(): invalid transform size %v", out.Size())
+	}
+	if v := out.GetDoubleAt(0, 0); math.Abs(v-2) > 1e-3 {
+		t.Errorf("TestEstimateAffine3D(): wrong value = %v, want = %v", v, 2)
+	}
+	if v := out.GetDoubleAt(2, 3); math.Abs(v-3) > 1e-3 {
+		t.Errorf("TestEstimateAffine3D(): wrong value = %v, want = %v", v, 3)
+	}
+	if n := CountNonZero(inliers); n != len(src) {
+		t.Errorf("TestEstimateAffine3D(): expected %d inliers, got %d", len(src), n)
+	}
+}
+
+func TestDecomposeHomographyMat(t *testing.T) {
+	// two views of the plane z = 10, in normalized image coordinates.
+	var plane []Point3f
+	for x := float32(-2); x <= 2; x++ {
+		for y := float32(-2); y <= 2; y++ {
+			plane = append(plane, Point3f{x, y, 10})
+		}
+	}
+
+	k := Eye(3, 3, MatTypeCV64F)
+	defer k.Close()
+	distCoeffs := NewMat()
+	defer distCoeffs.Close()
+
+	rvec1 := newVec3d(0, 0, 0)
+	defer rvec1.Close()
+	tvec1 := newVec3d(0, 0, 0)
+	defer tvec1.Close()
+	before := ProjectPoints(plane, rvec1, tvec1, k, distCoeffs)
+
+	rvec2 := newVec3d(0, 0.1, 0)
+	defer rvec2.Close()
+	tvec2 := newVec3d(-1, 0, 0)
+	defer tvec2.Close()
+	after := ProjectPoints(plane, rvec2, tvec2, k, distCoeffs)
+
+	src := NewMatWithSize(len(before), 2, MatTypeCV32F)
+	defer src.Close()
+	dst := NewMatWithSize(len(after), 2, MatTypeCV32F)
+	defer dst.Close()
+	for i := range before {
+		src.SetFloatAt(i, 0, before[i].X)
+		src.SetFloatAt(i, 1, before[i].Y)
+		dst.SetFloatAt(i, 0, after[i].X)
+		dst.SetFloatAt(i, 1, after[i].Y)
+	}
+
+	mask := NewMat()
+	defer mask.Close()
+
+	h := FindHomography(src, &dst, HomograpyMethodAllPoints, 3, &mask, 2000, 0.995)
+	defer h.Close()
+
+	rotations, translations, normals := DecomposeHomographyMat(h, k)
+	defer closeMats(rotations)
+	defer closeMats(translations)
+	defer closeMats(normals)
+
+	if len(rotations) == 0 || len(rotations) != len(translations) || len(rotations) != len(normals) {
+		t.Fatalf("TestDecomposeHomographyMat(): invalid number of solutions %d %d %d", len(rotations), len(translations), len(normals))
+	}
+
+	possibleSolutions := NewMat()
+	defer possibleSolutions.Close()
+	pointsMask := NewMat()
+	defer pointsMask.Close()
+
+	FilterHomographyDecompByVisibleRefpoints(rotations, normals, before, after, &possibleSolutions, pointsMask)
+
+	if possibleSolutions.Total() == 0 || possibleSolutions.Total() > len(rotations) {
+		t.Fatalf("TestFilterHomographyDecompByVisibleRefpoints(): invalid number of solutions %d", possibleSolutions.Total())
+	}
+
+	found := false
+	for i := 0; i < possibleSolutions.Total(); i++ {
+		n := normals[possibleSolutions.GetIntAt(i, 0)]
+		if math.Abs(n.GetDoubleAt(2, 0)) > 0.99 {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("TestFilterHomographyDecompByVisibleRefpoints(): plane normal not among the solutions")
+	}
+}
+
+func TestDecomposeProjectionMatrix(t *testing.T) {
+	// P = K [I | t] with t = (1, 2, 3).
+	p := NewMatWithSize(3, 4, MatTypeCV64F)
+	defer p.Close()
+	p.SetDoubleAt(0, 0, 800)
+	p.SetDoubleAt(0, 2, 320)
+	p.SetDoubleAt(0, 3, 800*1+320*3)
+	p.SetDoubleAt(1, 1, 800)
+	p.SetDoubleAt(1, 2, 240)
+	p.SetDoubleAt(1, 3, 800*2+240*3)
+	p.SetDoubleAt(2, 2, 1)
+	p.SetDoubleAt(2, 3, 3)
+
+	cameraMatrix := NewMat()
+	defer cameraMatrix.Close()
+	rotMatrix := NewMat()
+	defer rotMatrix.Close()
+	transVect := NewMat()
+	defer transVect.Close()
+
+	DecomposeProjectionMatrix(p, &cameraMatrix, &rotMatrix, &transVect)
+
+	if v := cameraMatrix.GetDoubleAt(0, 0); math.Abs(v-800) > 1e-6 {
+		t.Errorf("TestDecomposeProjectionMatrix(): wrong fx = %v, want = %v", v, 800)
+	}
+	if v := rotMatrix.GetDoubleAt(1, 1); math.Abs(v-1) > 1e-6 {
+		t.Errorf("TestDecomposeProjectionMatrix(): wrong rotation value = %v, want = %v", v, 1)
+	}
+
+	// the camera center is -t, in homogeneous coordinates.
+	w := transVect.GetDoubleAt(3, 0)
+	for i, want := range []float64{-1, -2, -3} {
+		if v := transVect.GetDoubleAt(i, 0) / w; math.Abs(v-want) > 1e-6 {
+			t.Errorf("TestDecomposeProjectionMatrix(): wrong camera center value = %v, want = %v", v, want)
+		}
+	}
+
+	rotMatrixX := NewMat()
+	defer rotMatrixX.Close()
+	rotMatrixY := NewMat()
+	defer rotMatrixY.Close()
+	rotMatrixZ := NewMat()
+	defer rotMatrixZ.Close()
+	eulerAngles := NewMat()
+	defer eulerAngles.Close()
+
+	DecomposeProjectionMatrixWithParams(p, &cameraMatrix, &rotMatrix, &transVect, &rotMatrixX, &rotMatrixY, &rotMatrixZ, &eulerAngles)
+	if rotMatrixZ.Rows() != 3 || eulerAngles.Total() != 3 {
+		t.Errorf("TestDecomposeProjectionMatrixWithParams(): invalid outputs %v %v", rotMatrixZ.Size(), eulerAngles.Size())
+	}
+}
+
+func TestRQDecomp3x3(t *testing.T) {
+	// src = K * Rz(30 degrees)
+	theta := math.Pi / 6
+	k := [3][3]float64{{800, 0, 320}, {0, 800, 240}, {0, 0, 1}}
+	rz := [3][3]float64{{math.Cos(theta), -math.Sin(theta), 0}, {math.Sin(theta), math.Cos(theta), 0}, {0, 0, 1}}
+
+	src := NewMatWithSize(3, 3, MatTypeCV64F)
+	defer src.Close()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var v float64
+			for l := 0; l < 3; l++ {
+				v += k[i][l] * rz[l][j]
+			}
+			src.SetDoubleAt(i, j, v)
+		}
+	}
+
+	mtxR := NewMat()
+	defer mtxR.Close()
+	mtxQ := NewMat()
+	defer mtxQ.Close()
+
+	angles := RQDecomp3x3(src, &mtxR, &mtxQ)
+	if math.Abs(math.Abs(angles[2])-30) > 1e-6 {
+		t.Errorf("TestRQDecomp3x3(): wrong z angle = %v, want = %v", angles[2], 30)
+	}
+	for _, rc := range [][2]int{{1, 0}, {2, 0}, {2, 1}} {
+		if v := mtxR.GetDoubleAt(rc[0], rc[1]); math.Abs(v) > 1e-6 {
+			t.Errorf("TestRQDecomp3x3(): R is not upper triangular, got %v at %v", v, rc)
+		}
+	}
+
+	qx := NewMat()
+	defer qx.Close()
+	qy := NewMat()
+	defer qy.Close()
+	qz := NewMat()
+	defer qz.Close()
+
+	angles2 := RQDecomp3x3WithParams(src, &mtxR, &mtxQ, &qx, &qy, &qz)
+	if angles2 != angles {
+		t.Errorf("TestRQDecomp3x3WithParams(): wrong angles = %v, want = %v", angles2, angles)
+	}
+	if qz.Rows() != 3 || qz.Cols() != 3 {
+		t.Errorf("TestRQDecomp3x3WithParams(): invalid qz size %v", qz.Size())
+	}
+}
@@ -1,6 +1,7 @@
 // What it does:
 //
-// This example shows how to find chessboard patterns in an image
+// This example shows how to find chessboard patterns in one or more images,
+// and how to use them to calibrate the camera that took the images.
 //
 // How to run:
 //
@@ -20,40 +21,80 @@ import (
 
 func main() {
 	if len(os.Args) < 2 {
-		fmt.Println("How to run:\n\tfind-chessboard [imgfile]")
+		fmt.Println("How to run:\n\tfind-chessboard [imgfile] [imgfile...]")
 		return
 	}
 
-	filename := os.Args[1]
-	img := gocv.IMRead(filename, gocv.IMReadColor)
+	sz := image.Point{X: 4, Y: 6}
 
-	if img.Empty() {
-		fmt.Printf("Error reading chessboard image")
-		return
-	}
-	defer img.Close()
+	var objectPoints, imagePoints []gocv.Mat
+	defer func() {
+		for _, m := range objectPoints {
+			m.Close()
+		}
+		for _, m := range imagePoints {
+			m.Close()
+		}
+	}()
 
-	corners := gocv.NewMat()
-	defer corners.Close()
-	sz := image.Point{X: 4, Y: 6}
-	found := gocv.FindChessboardCorners(img, sz, &corners, 0)
-	if found == false {
-		fmt.Printf("chessboard pattern not found")
-		return
+	var imageSize image.Point
+	clone := gocv.NewMat()
+	defer clone.Close()
+
+	for _, filename := range os.Args[1:] {
+		img := gocv.IMRead(filename, gocv.IMReadColor)
+		if img.Empty() {
+			fmt.Printf("Error reading chessboard image %s\n", filename)
+			continue
+		}
+
+		corners := gocv.NewMat()
+		found := gocv.FindChessboardCorners(img, sz, &corners, 0)
+		if !found || corners.Empty() {
+			fmt.Printf("chessboard pattern not found in %s\n", filename)
+			corners.Close()
+			img.Close()
+			continue
+		}
+
+		fmt.Printf("Corners Found in %s. Size: %+v Rows: %+v Cols: %+v\n", filename, corners.Size(), corners.Rows(), corners.Cols())
+
+		imageSize = image.Pt(img.Cols(), img.Rows())
+		objectPoints = append(objectPoints, chessboardPoints(sz))
+		imagePoints = append(imagePoints, corners)
+
+		img.CopyTo(&clone)
+		gocv.DrawChessboardCorners(&clone, sz, corners, found)
+		img.Close()
 	}
-	if corners.Empty() {
-		fmt.Printf("corners mat is empty")
+
+	if len(imagePoints) == 0 {
+		fmt.Println("no chessboard pattern found")
 		return
 	}
 
-	fmt.Printf("Corners Found. Size: %+v Rows: %+v Cols: %+v\n", corners.Size(), corners.Rows(), corners.Cols())
-	clone := img.Clone()
-	defer clone.Close()
-	gocv.DrawChessboardCorners(&clone, sz, corners, found)
-	if clone.Empty() {
-		fmt.Printf("Error writing to chessboard image")
-		return
+	cameraMatrix := gocv.NewMat()
+	defer cameraMatrix.Close()
+	distCoeffs := gocv.NewMat()
+	defer distCoeffs.Close()
+	rvecs := gocv.NewMat()
+	defer rvecs.Close()
+	tvecs := gocv.NewMat()
+	defer tvecs.Close()
+
+	criteria := gocv.NewTermCriteria(gocv.Count|gocv.EPS, 30, 1e-6)
+	rms := gocv.CalibrateCamera(objectPoints, imagePoints, imageSize, &cameraMatrix, &distCoeffs, &rvecs, &tvecs, 0, criteria)
+
+	fmt.Printf("Calibrated from %d views. RMS re-projection error: %v\n", len(imagePoints), rms)
+	fmt.Println("Camera matrix:")
+	for r := 0; r < cameraMatrix.Rows(); r++ {
+		fmt.Printf("\t%v %v %v\n", cameraMatrix.GetDoubleAt(r, 0), cameraMatrix.GetDoubleAt(r, 1), cameraMatrix.GetDoubleAt(r, 2))
+	}
+	fmt.Print("Distortion coefficients:")
+	for c := 0; c < distCoeffs.Cols(); c++ {
+		fmt.Printf(" %v", distCoeffs.GetDoubleAt(0, c))
 	}
+	fmt.Println()
 
 	window := gocv.NewWindow("Chessboards")
 	defer window.Close()
@@ -65,3 +106,16 @@ func main() {
 		}
 	}
 }
+
+// chessboardPoints returns the inner corners of a chessboard pattern of the
+// given size, in units of chessboard squares, in the order they are returned
+// by FindChessboardCorners.
+func chessboardPoints(sz image.Point) gocv.Mat {
+	pts := gocv.NewMatWithSize(sz.X*sz.Y, 3, gocv.MatTypeCV32F)
+	for i := 0; i < sz.X*sz.Y; i++ {
+		pts.SetFloatAt(i, 0, float32(i%sz.X))
+		pts.SetFloatAt(i, 1, float32(i/sz.X))
+		pts.SetFloatAt(i, 2, 0)
+	}
+	return pts
+}
@@ -1,6 +1,21 @@
 #include "core.h"
 #include <string.h>
 
+// catchException runs f and converts any exception it raises to an OpenCVResult,
+// so that it does not unwind through the cgo boundary and abort the process.
+template <typename F>
+static OpenCVResult catchException(F f) {
+    try {
+        f();
+    } catch (const cv::Exception& e) {
+        return OpenCVResult{e.code, strdup(e.func.c_str()), strdup(e.file.c_str()), e.line, strdup(e.err.c_str())};
+    } catch (const std::exception& e) {
+        return OpenCVResult{cv::Error::StsError, strdup(""), strdup(""), 0, strdup(e.what())};
+    }
+
+    return OpenCVResult{0, NULL, NULL, 0, NULL};
+}
+
 // Mat_New creates a new empty Mat
 Mat Mat_New() {
     return new cv::Mat();
@@ -11,6 +26,12 @@ Mat Mat_NewWithSize(int rows, int cols, int type) {
     return new cv::Mat(rows, cols, type, 0.0);
 }
 
+OpenCVResult Mat_NewWithSizeE(int rows, int cols, int type, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(rows, cols, type, 0.0);
+    });
+}
+
 // Mat_NewWithSizes creates a new Mat with specific dimension sizes and number of channels.
 Mat Mat_NewWithSizes(struct IntVector sizes, int type) {
 	std::vector<int> sizess;
@@ -20,6 +41,16 @@ Mat Mat_NewWithSizes(struct IntVector sizes, int type) {
     return new cv::Mat(sizess, type);
 }
 
+OpenCVResult Mat_NewWithSizesE(struct IntVector sizes, int type, Mat* res) {
+    return catchException([&] {
+        std::vector<int> sizess;
+        for (int i = 0; i < sizes.length; ++i) {
+            sizess.push_back(sizes.val[i]);
+        }
+        *res = new cv::Mat(sizess, type);
+    });
+}
+
 // Mat_NewFromScalar creates a new Mat from a Scalar. Intended to be used
 // for Mat comparison operation such as InRange.
 Mat Mat_NewFromScalar(Scalar ar, int type) {
@@ -27,16 +58,34 @@ Mat Mat_NewFromScalar(Scalar ar, int type) {
     return new cv::Mat(1, 1, type, c);
 }
 
+OpenCVResult Mat_NewFromScalarE(Scalar ar, int type, Mat* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::Scalar(ar.val1, ar.val2, ar.val3, ar.val4);
+        *res = new cv::Mat(1, 1, type, c);
+    });
+}
+
 // Mat_NewWithSizeFromScalar creates a new Mat from a Scalar with a specific size dimension and number of channels
 Mat Mat_NewWithSizeFromScalar(Scalar ar, int rows, int cols, int type) {
     cv::Scalar c = cv::Scalar(ar.val1, ar.val2, ar.val3, ar.val4);
     return new cv::Mat(rows, cols, type, c);
 }
 
+OpenCVResult Mat_NewWithSizeFromScalarE(Scalar ar, int rows, int cols, int type, Mat* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::Scalar(ar.val1, ar.val2, ar.val3, ar.val4);
+        *res = new cv::Mat(rows, cols, type, c);
+    });
+}
+
 Mat Mat_NewFromBytes(int rows, int cols, int type, struct ByteArray buf) {
     return new cv::Mat(rows, cols, type, buf.data);
 }
 
+Mat Mat_NewFromBytesWithStep(int rows, int cols, int type, struct ByteArray buf, int step) {
+    return new cv::Mat(rows, cols, type, buf.data, step);
+}
+
 // Mat_NewWithSizesFromScalar creates multidimensional Mat from a scalar
 Mat Mat_NewWithSizesFromScalar(IntVector sizes, int type, Scalar ar) {
     std::vector<int> _sizes;
@@ -48,6 +97,18 @@ Mat Mat_NewWithSizesFromScalar(IntVector sizes, int type, Scalar ar) {
     return new cv::Mat(_sizes, type, c);
 }
 
+OpenCVResult Mat_NewWithSizesFromScalarE(IntVector sizes, int type, Scalar ar, Mat* res) {
+    return catchException([&] {
+        std::vector<int> _sizes;
+        for (int i = 0, *v = sizes.val; i < sizes.length; ++v, ++i) {
+            _sizes.push_back(*v);
+        }
+
+        cv::Scalar c = cv::Scalar(ar.val1, ar.val2, ar.val3, ar.val4);
+        *res = new cv::Mat(_sizes, type, c);
+    });
+}
+
 // Mat_NewWithSizesFromBytes creates multidimensional Mat from a bytes
 Mat Mat_NewWithSizesFromBytes(IntVector sizes, int type, struct ByteArray buf) {
     std::vector<int> _sizes;
@@ -63,16 +124,34 @@ Mat Eye(int rows, int cols, int type) {
     return new cv::Mat(rows, cols, type, temp.data);
 }
 
+OpenCVResult EyeE(int rows, int cols, int type, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(cv::Mat::eye(rows, cols, type));
+    });
+}
+
 Mat Zeros(int rows, int cols, int type) {
     cv::Mat temp = cv::Mat::zeros(rows, cols, type);
     return new cv::Mat(rows, cols, type, temp.data);
 }
 
+OpenCVResult ZerosE(int rows, int cols, int type, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(cv::Mat::zeros(rows, cols, type));
+    });
+}
+
 Mat Ones(int rows, int cols, int type) {
     cv::Mat temp = cv::Mat::ones(rows, cols, type);
     return new cv::Mat(rows, cols, type, temp.data);
 }
 
+OpenCVResult OnesE(int rows, int cols, int type, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(cv::Mat::ones(rows, cols, type));
+    });
+}
+
 Mat Mat_FromPtr(Mat m, int rows, int cols, int type, int prow, int pcol) {
     return new cv::Mat(rows, cols, type, m->ptr(prow, pcol));
 }
@@ -97,24 +176,54 @@ Mat Mat_Clone(Mat m) {
     return new cv::Mat(m->clone());
 }
 
+OpenCVResult Mat_CloneE(Mat m, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(m->clone());
+    });
+}
+
 // Mat_CopyTo copies this Mat to another Mat.
 void Mat_CopyTo(Mat m, Mat dst) {
     m->copyTo(*dst);
 }
 
+OpenCVResult Mat_CopyToE(Mat m, Mat dst) {
+    return catchException([&] {
+        m->copyTo(*dst);
+    });
+}
+
 // Mat_CopyToWithMask copies this Mat to another Mat while applying the mask
 void Mat_CopyToWithMask(Mat m, Mat dst, Mat mask) {
     m->copyTo(*dst, *mask);
 }
 
+OpenCVResult Mat_CopyToWithMaskE(Mat m, Mat dst, Mat mask) {
+    return catchException([&] {
+        m->copyTo(*dst, *mask);
+    });
+}
+
 void Mat_ConvertTo(Mat m, Mat dst, int type) {
     m->convertTo(*dst, type);
 }
 
+OpenCVResult Mat_ConvertToE(Mat m, Mat dst, int type) {
+    return catchException([&] {
+        m->convertTo(*dst, type);
+    });
+}
+
 void Mat_ConvertToWithParams(Mat m, Mat dst, int type, float alpha, float beta) {
     m->convertTo(*dst, type, alpha, beta);
 }
 
+OpenCVResult Mat_ConvertToWithParamsE(Mat m, Mat dst, int type, float alpha, float beta) {
+    return catchException([&] {
+        m->convertTo(*dst, type, alpha, beta);
+    });
+}
+
 // Mat_ToBytes returns the bytes representation of the underlying data.
 struct ByteArray Mat_ToBytes(Mat m) {
     return toByteArray(reinterpret_cast<const char*>(m->data), m->total() * m->elemSize());
@@ -129,26 +238,60 @@ Mat Mat_Region(Mat m, Rect r) {
     return new cv::Mat(*m, cv::Rect(r.x, r.y, r.width, r.height));
 }
 
+OpenCVResult Mat_RegionE(Mat m, Rect r, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(*m, cv::Rect(r.x, r.y, r.width, r.height));
+    });
+}
+
 Mat Mat_Reshape(Mat m, int cn, int rows) {
     return new cv::Mat(m->reshape(cn, rows));
 }
 
+OpenCVResult Mat_ReshapeE(Mat m, int cn, int rows, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(m->reshape(cn, rows));
+    });
+}
+
 void Mat_PatchNaNs(Mat m) {
     cv::patchNaNs(*m);
 }
 
+OpenCVResult Mat_PatchNaNsE(Mat m) {
+    return catchException([&] {
+        cv::patchNaNs(*m);
+    });
+}
+
 Mat Mat_ConvertFp16(Mat m) {
     Mat dst = new cv::Mat();
     cv::convertFp16(*m, *dst);
     return dst;
 }
 
+OpenCVResult Mat_ConvertFp16E(Mat m, Mat* res) {
+    return catchException([&] {
+        cv::Mat dst;
+        cv::convertFp16(*m, dst);
+        *res = new cv::Mat(dst);
+    });
+}
+
 Mat Mat_Sqrt(Mat m) {
     Mat dst = new cv::Mat();
     cv::sqrt(*m, *dst);
     return dst;
 }
 
+OpenCVResult Mat_SqrtE(Mat m, Mat* res) {
+    return catchException([&] {
+        cv::Mat dst;
+        cv::sqrt(*m, dst);
+        *res = new cv::Mat(dst);
+    });
+}
+
 // Mat_Mean calculates the mean value M of array elements, independently for each channel, and return it as Scalar vector
 Scalar Mat_Mean(Mat m) {
     cv::Scalar c = cv::mean(*m);
@@ -160,6 +303,18 @@ Scalar Mat_Mean(Mat m) {
     return scal;
 }
 
+OpenCVResult Mat_MeanE(Mat m, Scalar* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::mean(*m);
+        Scalar scal = Scalar();
+        scal.val1 = c.val[0];
+        scal.val2 = c.val[1];
+        scal.val3 = c.val[2];
+        scal.val4 = c.val[3];
+        *res = scal;
+    });
+}
+
 // Mat_MeanWithMask calculates the mean value M of array elements,
 // independently for each channel, and returns it as Scalar vector
 // while applying the mask.
@@ -174,10 +329,28 @@ Scalar Mat_MeanWithMask(Mat m, Mat mask){
     return scal;
 }
 
+OpenCVResult Mat_MeanWithMaskE(Mat m, Mat mask, Scalar* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::mean(*m, *mask);
+        Scalar scal = Scalar();
+        scal.val1 = c.val[0];
+        scal.val2 = c.val[1];
+        scal.val3 = c.val[2];
+        scal.val4 = c.val[3];
+        *res = scal;
+    });
+}
+
 void LUT(Mat src, Mat lut, Mat dst) {
     cv::LUT(*src, *lut, *dst);
 }
 
+OpenCVResult LUTE(Mat src, Mat lut, Mat dst) {
+    return catchException([&] {
+        cv::LUT(*src, *lut, *dst);
+    });
+}
+
 // Mat_Rows returns how many rows in this Mat.
 int Mat_Rows(Mat m) {
     return m->rows;
@@ -203,6 +376,18 @@ int Mat_Step(Mat m) {
     return m->step;
 }
 
+// Mat_Steps returns the number of bytes between consecutive elements of each dimension.
+void Mat_Steps(Mat m, IntVector* res) {
+    int* steps = new int[m->dims];
+
+    for (int i = 0; i < m->dims; ++i) {
+        steps[i] = (int)m->step[i];
+    }
+
+    res->length = m->dims;
+    res->val = steps;
+}
+
 int Mat_Total(Mat m) {
     return m->total();
 }
@@ -285,6 +470,13 @@ void Mat_SetTo(Mat m, Scalar value) {
     m->setTo(c_value);
 }
 
+OpenCVResult Mat_SetToE(Mat m, Scalar value) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        m->setTo(c_value);
+    });
+}
+
 // Mat_SetUChar set a specific row/col value from this Mat expecting
 // each element to contain a schar aka CV_8U.
 void Mat_SetUChar(Mat m, int row, int col, uint8_t val) {
@@ -349,174 +541,539 @@ void Mat_AddUChar(Mat m, uint8_t val) {
     *m += val;
 }
 
+OpenCVResult Mat_AddUCharE(Mat m, uint8_t val) {
+    return catchException([&] {
+        *m += val;
+    });
+}
+
 void Mat_SubtractUChar(Mat m, uint8_t val) {
     *m -= val;
 }
 
+OpenCVResult Mat_SubtractUCharE(Mat m, uint8_t val) {
+    return catchException([&] {
+        *m -= val;
+    });
+}
+
 void Mat_MultiplyUChar(Mat m, uint8_t val) {
     *m *= val;
 }
 
+OpenCVResult Mat_MultiplyUCharE(Mat m, uint8_t val) {
+    return catchException([&] {
+        *m *= val;
+    });
+}
+
 void Mat_DivideUChar(Mat m, uint8_t val) {
     *m /= val;
 }
 
+OpenCVResult Mat_DivideUCharE(Mat m, uint8_t val) {
+    return catchException([&] {
+        *m /= val;
+    });
+}
+
 void Mat_AddFloat(Mat m, float val) {
     *m += val;
 }
 
+OpenCVResult Mat_AddFloatE(Mat m, float val) {
+    return catchException([&] {
+        *m += val;
+    });
+}
+
 void Mat_SubtractFloat(Mat m, float val) {
     *m -= val;
 }
 
+OpenCVResult Mat_SubtractFloatE(Mat m, float val) {
+    return catchException([&] {
+        *m -= val;
+    });
+}
+
 void Mat_MultiplyFloat(Mat m, float val) {
     *m *= val;
 }
 
+OpenCVResult Mat_MultiplyFloatE(Mat m, float val) {
+    return catchException([&] {
+        *m *= val;
+    });
+}
+
 void Mat_DivideFloat(Mat m, float val) {
     *m /= val;
 }
 
+OpenCVResult Mat_DivideFloatE(Mat m, float val) {
+    return catchException([&] {
+        *m /= val;
+    });
+}
+
 Mat Mat_MultiplyMatrix(Mat x, Mat y) {
     return new cv::Mat((*x) * (*y));
 }
 
+OpenCVResult Mat_MultiplyMatrixE(Mat x, Mat y, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat((*x) * (*y));
+    });
+}
+
 Mat Mat_T(Mat x) {
     return new cv::Mat(x->t());
 }
 
+OpenCVResult Mat_TE(Mat x, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(x->t());
+    });
+}
+
 void Mat_AbsDiff(Mat src1, Mat src2, Mat dst) {
     cv::absdiff(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_AbsDiffE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::absdiff(*src1, *src2, *dst);
+    });
+}
+
+void Mat_AbsDiffScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::absdiff(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_AbsDiffScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::absdiff(*src, c_value, *dst);
+    });
+}
+
+void Mat_Abs(Mat src, Mat dst) {
+    *dst = cv::abs(*src);
+}
+
+OpenCVResult Mat_AbsE(Mat src, Mat dst) {
+    return catchException([&] {
+        *dst = cv::abs(*src);
+    });
+}
+
 void Mat_Add(Mat src1, Mat src2, Mat dst) {
     cv::add(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_AddE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::add(*src1, *src2, *dst);
+    });
+}
+
+void Mat_AddWithParams(Mat src1, Mat src2, Mat dst, Mat mask, int dtype) {
+    cv::add(*src1, *src2, *dst, *mask, dtype);
+}
+
+OpenCVResult Mat_AddWithParamsE(Mat src1, Mat src2, Mat dst, Mat mask, int dtype) {
+    return catchException([&] {
+        cv::add(*src1, *src2, *dst, *mask, dtype);
+    });
+}
+
+void Mat_AddScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::add(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_AddScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::add(*src, c_value, *dst);
+    });
+}
+
+void Mat_AddScalarWithParams(Mat src, Scalar value, Mat dst, Mat mask, int dtype) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::add(*src, c_value, *dst, *mask, dtype);
+}
+
+OpenCVResult Mat_AddScalarWithParamsE(Mat src, Scalar value, Mat dst, Mat mask, int dtype) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::add(*src, c_value, *dst, *mask, dtype);
+    });
+}
+
 void Mat_AddWeighted(Mat src1, double alpha, Mat src2, double beta, double gamma, Mat dst) {
     cv::addWeighted(*src1, alpha, *src2, beta, gamma, *dst);
 }
 
+OpenCVResult Mat_AddWeightedE(Mat src1, double alpha, Mat src2, double beta, double gamma, Mat dst) {
+    return catchException([&] {
+        cv::addWeighted(*src1, alpha, *src2, beta, gamma, *dst);
+    });
+}
+
 void Mat_BitwiseAnd(Mat src1, Mat src2, Mat dst) {
     cv::bitwise_and(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_BitwiseAndE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::bitwise_and(*src1, *src2, *dst);
+    });
+}
+
 void Mat_BitwiseAndWithMask(Mat src1, Mat src2, Mat dst, Mat mask){
     cv::bitwise_and(*src1, *src2, *dst, *mask);
 }
 
+OpenCVResult Mat_BitwiseAndWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask) {
+    return catchException([&] {
+        cv::bitwise_and(*src1, *src2, *dst, *mask);
+    });
+}
+
 void Mat_BitwiseNot(Mat src1, Mat dst) {
     cv::bitwise_not(*src1, *dst);
 }
 
+OpenCVResult Mat_BitwiseNotE(Mat src1, Mat dst) {
+    return catchException([&] {
+        cv::bitwise_not(*src1, *dst);
+    });
+}
+
 void Mat_BitwiseNotWithMask(Mat src1, Mat dst, Mat mask) {
     cv::bitwise_not(*src1, *dst, *mask);
 }
 
+OpenCVResult Mat_BitwiseNotWithMaskE(Mat src1, Mat dst, Mat mask) {
+    return catchException([&] {
+        cv::bitwise_not(*src1, *dst, *mask);
+    });
+}
+
 void Mat_BitwiseOr(Mat src1, Mat src2, Mat dst) {
     cv::bitwise_or(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_BitwiseOrE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::bitwise_or(*src1, *src2, *dst);
+    });
+}
+
 void Mat_BitwiseOrWithMask(Mat src1, Mat src2, Mat dst, Mat mask) {
     cv::bitwise_or(*src1, *src2, *dst, *mask);
 }
 
+OpenCVResult Mat_BitwiseOrWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask) {
+    return catchException([&] {
+        cv::bitwise_or(*src1, *src2, *dst, *mask);
+    });
+}
+
 void Mat_BitwiseXor(Mat src1, Mat src2, Mat dst) {
     cv::bitwise_xor(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_BitwiseXorE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::bitwise_xor(*src1, *src2, *dst);
+    });
+}
+
 void Mat_BitwiseXorWithMask(Mat src1, Mat src2, Mat dst, Mat mask) {
     cv::bitwise_xor(*src1, *src2, *dst, *mask);
 }
 
+OpenCVResult Mat_BitwiseXorWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask) {
+    return catchException([&] {
+        cv::bitwise_xor(*src1, *src2, *dst, *mask);
+    });
+}
+
 void Mat_BatchDistance(Mat src1, Mat src2, Mat dist, int dtype, Mat nidx, int normType, int K,
                        Mat mask, int update, bool crosscheck) {
     cv::batchDistance(*src1, *src2, *dist, dtype, *nidx, normType, K, *mask, update, crosscheck);
 }
 
+OpenCVResult Mat_BatchDistanceE(Mat src1, Mat src2, Mat dist, int dtype, Mat nidx, int normType, int K,
+                                Mat mask, int update, bool crosscheck) {
+    return catchException([&] {
+        cv::batchDistance(*src1, *src2, *dist, dtype, *nidx, normType, K, *mask, update, crosscheck);
+    });
+}
+
 int Mat_BorderInterpolate(int p, int len, int borderType) {
     return cv::borderInterpolate(p, len, borderType);
 }
 
+OpenCVResult Mat_BorderInterpolateE(int p, int len, int borderType, int* res) {
+    return catchException([&] {
+        *res = cv::borderInterpolate(p, len, borderType);
+    });
+}
+
 void  Mat_CalcCovarMatrix(Mat samples, Mat covar, Mat mean, int flags, int ctype) {
     cv::calcCovarMatrix(*samples, *covar, *mean, flags, ctype);
 }
 
+OpenCVResult Mat_CalcCovarMatrixE(Mat samples, Mat covar, Mat mean, int flags, int ctype) {
+    return catchException([&] {
+        cv::calcCovarMatrix(*samples, *covar, *mean, flags, ctype);
+    });
+}
+
 void  Mat_CartToPolar(Mat x, Mat y, Mat magnitude, Mat angle, bool angleInDegrees) {
     cv::cartToPolar(*x, *y, *magnitude, *angle, angleInDegrees);
 }
 
+OpenCVResult Mat_CartToPolarE(Mat x, Mat y, Mat magnitude, Mat angle, bool angleInDegrees) {
+    return catchException([&] {
+        cv::cartToPolar(*x, *y, *magnitude, *angle, angleInDegrees);
+    });
+}
+
 bool Mat_CheckRange(Mat m) {
     return cv::checkRange(*m);
 }
 
+OpenCVResult Mat_CheckRangeE(Mat m, bool* res) {
+    return catchException([&] {
+        *res = cv::checkRange(*m);
+    });
+}
+
 void Mat_Compare(Mat src1, Mat src2, Mat dst, int ct) {
     cv::compare(*src1, *src2, *dst, ct);
 }
 
+OpenCVResult Mat_CompareE(Mat src1, Mat src2, Mat dst, int ct) {
+    return catchException([&] {
+        cv::compare(*src1, *src2, *dst, ct);
+    });
+}
+
+void Mat_CompareScalar(Mat src, double value, Mat dst, int ct) {
+    cv::compare(*src, value, *dst, ct);
+}
+
+OpenCVResult Mat_CompareScalarE(Mat src, double value, Mat dst, int ct) {
+    return catchException([&] {
+        cv::compare(*src, value, *dst, ct);
+    });
+}
+
 int Mat_CountNonZero(Mat src) {
     return cv::countNonZero(*src);
 }
 
+OpenCVResult Mat_CountNonZeroE(Mat src, int* res) {
+    return catchException([&] {
+        *res = cv::countNonZero(*src);
+    });
+}
+
 
 void Mat_CompleteSymm(Mat m, bool lowerToUpper) {
     cv::completeSymm(*m, lowerToUpper);
 }
 
+OpenCVResult Mat_CompleteSymmE(Mat m, bool lowerToUpper) {
+    return catchException([&] {
+        cv::completeSymm(*m, lowerToUpper);
+    });
+}
+
 void Mat_ConvertScaleAbs(Mat src, Mat dst, double alpha, double beta) {
     cv::convertScaleAbs(*src, *dst, alpha, beta);
 }
 
+OpenCVResult Mat_ConvertScaleAbsE(Mat src, Mat dst, double alpha, double beta) {
+    return catchException([&] {
+        cv::convertScaleAbs(*src, *dst, alpha, beta);
+    });
+}
+
 void Mat_CopyMakeBorder(Mat src, Mat dst, int top, int bottom, int left, int right, int borderType,
                         Scalar value) {
     cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
     cv::copyMakeBorder(*src, *dst, top, bottom, left, right, borderType, c_value);
 }
 
+OpenCVResult Mat_CopyMakeBorderE(Mat src, Mat dst, int top, int bottom, int left, int right, int borderType,
+                                 Scalar value) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::copyMakeBorder(*src, *dst, top, bottom, left, right, borderType, c_value);
+    });
+}
+
 void Mat_DCT(Mat src, Mat dst, int flags) {
     cv::dct(*src, *dst, flags);
 }
 
+OpenCVResult Mat_DCTE(Mat src, Mat dst, int flags) {
+    return catchException([&] {
+        cv::dct(*src, *dst, flags);
+    });
+}
+
 double Mat_Determinant(Mat m) {
     return cv::determinant(*m);
 }
 
+OpenCVResult Mat_DeterminantE(Mat m, double* res) {
+    return catchException([&] {
+        *res = cv::determinant(*m);
+    });
+}
+
 void Mat_DFT(Mat m, Mat dst, int flags) {
     cv::dft(*m, *dst, flags);
 }
 
+OpenCVResult Mat_DFTE(Mat m, Mat dst, int flags) {
+    return catchException([&] {
+        cv::dft(*m, *dst, flags);
+    });
+}
+
 void Mat_Divide(Mat src1, Mat src2, Mat dst) {
     cv::divide(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_DivideE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::divide(*src1, *src2, *dst);
+    });
+}
+
+void Mat_DivideWithParams(Mat src1, Mat src2, Mat dst, double scale, int dtype) {
+    cv::divide(*src1, *src2, *dst, scale, dtype);
+}
+
+OpenCVResult Mat_DivideWithParamsE(Mat src1, Mat src2, Mat dst, double scale, int dtype) {
+    return catchException([&] {
+        cv::divide(*src1, *src2, *dst, scale, dtype);
+    });
+}
+
+void Mat_DivideScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::divide(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_DivideScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::divide(*src, c_value, *dst);
+    });
+}
+
+void Mat_DivideScalarWithParams(Mat src, Scalar value, Mat dst, double scale, int dtype) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::divide(*src, c_value, *dst, scale, dtype);
+}
+
+OpenCVResult Mat_DivideScalarWithParamsE(Mat src, Scalar value, Mat dst, double scale, int dtype) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::divide(*src, c_value, *dst, scale, dtype);
+    });
+}
+
+void Mat_DivideFromScalar(double scale, Mat src, Mat dst, int dtype) {
+    cv::divide(scale, *src, *dst, dtype);
+}
+
+OpenCVResult Mat_DivideFromScalarE(double scale, Mat src, Mat dst, int dtype) {
+    return catchException([&] {
+        cv::divide(scale, *src, *dst, dtype);
+    });
+}
+
 bool Mat_Eigen(Mat src, Mat eigenvalues, Mat eigenvectors) {
     return cv::eigen(*src, *eigenvalues, *eigenvectors);
 }
 
+OpenCVResult Mat_EigenE(Mat src, Mat eigenvalues, Mat eigenvectors, bool* res) {
+    return catchException([&] {
+        *res = cv::eigen(*src, *eigenvalues, *eigenvectors);
+    });
+}
+
 void Mat_EigenNonSymmetric(Mat src, Mat eigenvalues, Mat eigenvectors) {
     cv::eigenNonSymmetric(*src, *eigenvalues, *eigenvectors);
 }
 
+OpenCVResult Mat_EigenNonSymmetricE(Mat src, Mat eigenvalues, Mat eigenvectors) {
+    return catchException([&] {
+        cv::eigenNonSymmetric(*src, *eigenvalues, *eigenvectors);
+    });
+}
+
 void Mat_Exp(Mat src, Mat dst) {
     cv::exp(*src, *dst);
 }
 
+OpenCVResult Mat_ExpE(Mat src, Mat dst) {
+    return catchException([&] {
+        cv::exp(*src, *dst);
+    });
+}
+
 void Mat_ExtractChannel(Mat src, Mat dst, int coi) {
     cv::extractChannel(*src, *dst, coi);
 }
 
+OpenCVResult Mat_ExtractChannelE(Mat src, Mat dst, int coi) {
+    return catchException([&] {
+        cv::extractChannel(*src, *dst, coi);
+    });
+}
+
 void Mat_FindNonZero(Mat src, Mat idx) {
     cv::findNonZero(*src, *idx);
 }
 
+OpenCVResult Mat_FindNonZeroE(Mat src, Mat idx) {
+    return catchException([&] {
+        cv::findNonZero(*src, *idx);
+    });
+}
+
 void Mat_Flip(Mat src, Mat dst, int flipCode) {
     cv::flip(*src, *dst, flipCode);
 }
 
+OpenCVResult Mat_FlipE(Mat src, Mat dst, int flipCode) {
+    return catchException([&] {
+        cv::flip(*src, *dst, flipCode);
+    });
+}
+
 void Mat_Gemm(Mat src1, Mat src2, double alpha, Mat src3, double beta, Mat dst, int flags) {
     cv::gemm(*src1, *src2, alpha, *src3, beta, *dst, flags);
 }
 
+OpenCVResult Mat_GemmE(Mat src1, Mat src2, double alpha, Mat src3, double beta, Mat dst, int flags) {
+    return catchException([&] {
+        cv::gemm(*src1, *src2, alpha, *src3, beta, *dst, flags);
+    });
+}
+
 int Mat_GetOptimalDFTSize(int vecsize) {
     return cv::getOptimalDFTSize(vecsize);
 }
@@ -525,46 +1082,108 @@ void Mat_Hconcat(Mat src1, Mat src2, Mat dst) {
     cv::hconcat(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_HconcatE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::hconcat(*src1, *src2, *dst);
+    });
+}
+
 void Mat_Vconcat(Mat src1, Mat src2, Mat dst) {
     cv::vconcat(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_VconcatE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::vconcat(*src1, *src2, *dst);
+    });
+}
+
 void Rotate(Mat src, Mat dst, int rotateCode) {
     cv::rotate(*src, *dst, rotateCode);
 }
 
+OpenCVResult RotateE(Mat src, Mat dst, int rotateCode) {
+    return catchException([&] {
+        cv::rotate(*src, *dst, rotateCode);
+    });
+}
+
 void Mat_Idct(Mat src, Mat dst, int flags) {
     cv::idct(*src, *dst, flags);
 }
 
+OpenCVResult Mat_IdctE(Mat src, Mat dst, int flags) {
+    return catchException([&] {
+        cv::idct(*src, *dst, flags);
+    });
+}
+
 void Mat_Idft(Mat src, Mat dst, int flags, int nonzeroRows) {
     cv::idft(*src, *dst, flags, nonzeroRows);
 }
 
+OpenCVResult Mat_IdftE(Mat src, Mat dst, int flags, int nonzeroRows) {
+    return catchException([&] {
+        cv::idft(*src, *dst, flags, nonzeroRows);
+    });
+}
+
 void Mat_InRange(Mat src, Mat lowerb, Mat upperb, Mat dst) {
     cv::inRange(*src, *lowerb, *upperb, *dst);
 }
 
+OpenCVResult Mat_InRangeE(Mat src, Mat lowerb, Mat upperb, Mat dst) {
+    return catchException([&] {
+        cv::inRange(*src, *lowerb, *upperb, *dst);
+    });
+}
+
 void Mat_InRangeWithScalar(Mat src, Scalar lowerb, Scalar upperb, Mat dst) {
     cv::Scalar lb = cv::Scalar(lowerb.val1, lowerb.val2, lowerb.val3, lowerb.val4);
     cv::Scalar ub = cv::Scalar(upperb.val1, upperb.val2, upperb.val3, upperb.val4);
     cv::inRange(*src, lb, ub, *dst);
 }
 
+OpenCVResult Mat_InRangeWithScalarE(Mat src, Scalar lowerb, Scalar upperb, Mat dst) {
+    return catchException([&] {
+        cv::Scalar lb = cv::Scalar(lowerb.val1, lowerb.val2, lowerb.val3, lowerb.val4);
+        cv::Scalar ub = cv::Scalar(upperb.val1, upperb.val2, upperb.val3, upperb.val4);
+        cv::inRange(*src, lb, ub, *dst);
+    });
+}
+
 void Mat_InsertChannel(Mat src, Mat dst, int coi) {
     cv::insertChannel(*src, *dst, coi);
 }
 
+OpenCVResult Mat_InsertChannelE(Mat src, Mat dst, int coi) {
+    return catchException([&] {
+        cv::insertChannel(*src, *dst, coi);
+    });
+}
+
 double Mat_Invert(Mat src, Mat dst, int flags) {
     double ret = cv::invert(*src, *dst, flags);
     return ret;
 }
 
+OpenCVResult Mat_InvertE(Mat src, Mat dst, int flags, double* res) {
+    return catchException([&] {
+        *res = cv::invert(*src, *dst, flags);
+    });
+}
+
 double KMeans(Mat data, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers) {
     double ret = cv::kmeans(*data, k, *bestLabels, *criteria, attempts, flags, *centers);
     return ret;
 }
 
+OpenCVResult KMeansE(Mat data, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers, double* res) {
+    return catchException([&] {
+        *res = cv::kmeans(*data, k, *bestLabels, *criteria, attempts, flags, *centers);
+    });
+}
+
 double KMeansPoints(PointVector points, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers) {
     std::vector<cv::Point2f> pts;
     copyPointVectorToPoint2fVector(points, &pts);
@@ -572,22 +1191,105 @@ double KMeansPoints(PointVector points, int k, Mat bestLabels, TermCriteria crit
     return ret;
 }
 
+OpenCVResult KMeansPointsE(PointVector points, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers, double* res) {
+    return catchException([&] {
+        std::vector<cv::Point2f> pts;
+        copyPointVectorToPoint2fVector(points, &pts);
+        *res = cv::kmeans(pts, k, *bestLabels, *criteria, attempts, flags, *centers);
+    });
+}
+
+// GoPartitionPredicate forwards the equivalence test of cv::partition to the
+// Go predicate registered under predicate, using element indices.
+struct GoPartitionPredicate {
+    int predicate;
+
+    bool operator()(const int& a, const int& b) const {
+        return goPartitionPredicate(predicate, a, b);
+    }
+};
+
+int Partition(int n, int predicate, IntVector* labels) {
+    std::vector<int> elems(n);
+    for (int i = 0; i < n; ++i) {
+        elems[i] = i;
+    }
+
+    std::vector<int> lbls;
+    GoPartitionPredicate pred = {predicate};
+    int nclasses = cv::partition(elems, lbls, pred);
+
+    labels->val = new int[lbls.size()];
+    for (size_t i = 0; i < lbls.size(); ++i) {
+        labels->val[i] = lbls[i];
+    }
+    labels->length = (int)lbls.size();
+
+    return nclasses;
+}
+
 void Mat_Log(Mat src, Mat dst) {
     cv::log(*src, *dst);
 }
 
+OpenCVResult Mat_LogE(Mat src, Mat dst) {
+    return catchException([&] {
+        cv::log(*src, *dst);
+    });
+}
+
 void Mat_Magnitude(Mat x, Mat y, Mat magnitude) {
     cv::magnitude(*x, *y, *magnitude);
 }
 
+OpenCVResult Mat_MagnitudeE(Mat x, Mat y, Mat magnitude) {
+    return catchException([&] {
+        cv::magnitude(*x, *y, *magnitude);
+    });
+}
+
+double Mat_Mahalanobis(Mat v1, Mat v2, Mat icovar) {
+    return cv::Mahalanobis(*v1, *v2, *icovar);
+}
+
+OpenCVResult Mat_MahalanobisE(Mat v1, Mat v2, Mat icovar, double* res) {
+    return catchException([&] {
+        *res = cv::Mahalanobis(*v1, *v2, *icovar);
+    });
+}
+
 void Mat_Max(Mat src1, Mat src2, Mat dst) {
     cv::max(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_MaxE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::max(*src1, *src2, *dst);
+    });
+}
+
+void Mat_MaxScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::max(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_MaxScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::max(*src, c_value, *dst);
+    });
+}
+
 void Mat_MeanStdDev(Mat src, Mat dstMean, Mat dstStdDev) {
     cv::meanStdDev(*src, *dstMean, *dstStdDev);
 }
 
+OpenCVResult Mat_MeanStdDevE(Mat src, Mat dstMean, Mat dstStdDev) {
+    return catchException([&] {
+        cv::meanStdDev(*src, *dstMean, *dstStdDev);
+    });
+}
+
 void Mat_Merge(struct Mats mats, Mat dst) {
     std::vector<cv::Mat> images;
 
@@ -598,14 +1300,56 @@ void Mat_Merge(struct Mats mats, Mat dst) {
     cv::merge(images, *dst);
 }
 
+OpenCVResult Mat_MergeE(struct Mats mats, Mat dst) {
+    return catchException([&] {
+        std::vector<cv::Mat> images;
+
+        for (int i = 0; i < mats.length; ++i) {
+            images.push_back(*mats.mats[i]);
+        }
+
+        cv::merge(images, *dst);
+    });
+}
+
 void Mat_Min(Mat src1, Mat src2, Mat dst) {
     cv::min(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_MinE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::min(*src1, *src2, *dst);
+    });
+}
+
+void Mat_MinScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::min(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_MinScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::min(*src, c_value, *dst);
+    });
+}
+
 void Mat_MinMaxIdx(Mat m, double* minVal, double* maxVal, int* minIdx, int* maxIdx) {
     cv::minMaxIdx(*m, minVal, maxVal, minIdx, maxIdx);
 }
 
+OpenCVResult Mat_MinMaxIdxE(Mat m, double* minVal, double* maxVal, int* minIdx, int* maxIdx) {
+    return catchException([&] {
+        // cv::minMaxIdx writes one index per dimension.
+        int cMinIdx[CV_MAX_DIM];
+        int cMaxIdx[CV_MAX_DIM];
+        cv::minMaxIdx(*m, minVal, maxVal, cMinIdx, cMaxIdx);
+
+        *minIdx = cMinIdx[0];
+        *maxIdx = cMaxIdx[0];
+    });
+}
+
 void Mat_MinMaxLoc(Mat m, double* minVal, double* maxVal, Point* minLoc, Point* maxLoc) {
     cv::Point cMinLoc;
     cv::Point cMaxLoc;
@@ -617,6 +1361,19 @@ void Mat_MinMaxLoc(Mat m, double* minVal, double* maxVal, Point* minLoc, Point*
     maxLoc->y = cMaxLoc.y;
 }
 
+OpenCVResult Mat_MinMaxLocE(Mat m, double* minVal, double* maxVal, Point* minLoc, Point* maxLoc) {
+    return catchException([&] {
+        cv::Point cMinLoc;
+        cv::Point cMaxLoc;
+        cv::minMaxLoc(*m, minVal, maxVal, &cMinLoc, &cMaxLoc);
+
+        minLoc->x = cMinLoc.x;
+        minLoc->y = cMinLoc.y;
+        maxLoc->x = cMaxLoc.x;
+        maxLoc->y = cMaxLoc.y;
+    });
+}
+
 void Mat_MixChannels(struct Mats src, struct Mats dst, struct IntVector fromTo) {
     std::vector<cv::Mat> srcMats;
 
@@ -639,70 +1396,254 @@ void Mat_MixChannels(struct Mats src, struct Mats dst, struct IntVector fromTo)
     cv::mixChannels(srcMats, dstMats, fromTos);
 }
 
+OpenCVResult Mat_MixChannelsE(struct Mats src, struct Mats dst, struct IntVector fromTo) {
+    return catchException([&] {
+        std::vector<cv::Mat> srcMats;
+
+        for (int i = 0; i < src.length; ++i) {
+            srcMats.push_back(*src.mats[i]);
+        }
+
+        std::vector<cv::Mat> dstMats;
+
+        for (int i = 0; i < dst.length; ++i) {
+            dstMats.push_back(*dst.mats[i]);
+        }
+
+        std::vector<int> fromTos;
+
+        for (int i = 0; i < fromTo.length; ++i) {
+            fromTos.push_back(fromTo.val[i]);
+        }
+
+        cv::mixChannels(srcMats, dstMats, fromTos);
+    });
+}
+
 void Mat_MulSpectrums(Mat a, Mat b, Mat c, int flags) {
     cv::mulSpectrums(*a, *b, *c, flags);
 }
 
+OpenCVResult Mat_MulSpectrumsE(Mat a, Mat b, Mat c, int flags) {
+    return catchException([&] {
+        cv::mulSpectrums(*a, *b, *c, flags);
+    });
+}
+
+void Mat_MulTransposed(Mat src, Mat dst, bool ata) {
+    cv::mulTransposed(*src, *dst, ata);
+}
+
+OpenCVResult Mat_MulTransposedE(Mat src, Mat dst, bool ata) {
+    return catchException([&] {
+        cv::mulTransposed(*src, *dst, ata);
+    });
+}
+
+void Mat_MulTransposedWithParams(Mat src, Mat dst, bool ata, Mat delta, double scale, int dtype) {
+    cv::mulTransposed(*src, *dst, ata, *delta, scale, dtype);
+}
+
+OpenCVResult Mat_MulTransposedWithParamsE(Mat src, Mat dst, bool ata, Mat delta, double scale, int dtype) {
+    return catchException([&] {
+        cv::mulTransposed(*src, *dst, ata, *delta, scale, dtype);
+    });
+}
+
 void Mat_Multiply(Mat src1, Mat src2, Mat dst) {
     cv::multiply(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_MultiplyE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::multiply(*src1, *src2, *dst);
+    });
+}
+
 void Mat_MultiplyWithParams(Mat src1, Mat src2, Mat dst, double scale, int dtype) {
     cv::multiply(*src1, *src2, *dst, scale, dtype);
 }
 
+OpenCVResult Mat_MultiplyWithParamsE(Mat src1, Mat src2, Mat dst, double scale, int dtype) {
+    return catchException([&] {
+        cv::multiply(*src1, *src2, *dst, scale, dtype);
+    });
+}
+
+void Mat_MultiplyScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::multiply(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_MultiplyScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::multiply(*src, c_value, *dst);
+    });
+}
+
+void Mat_MultiplyScalarWithParams(Mat src, Scalar value, Mat dst, double scale, int dtype) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::multiply(*src, c_value, *dst, scale, dtype);
+}
+
+OpenCVResult Mat_MultiplyScalarWithParamsE(Mat src, Scalar value, Mat dst, double scale, int dtype) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::multiply(*src, c_value, *dst, scale, dtype);
+    });
+}
+
 void Mat_Normalize(Mat src, Mat dst, double alpha, double beta, int typ) {
     cv::normalize(*src, *dst, alpha, beta, typ);
 }
 
+OpenCVResult Mat_NormalizeE(Mat src, Mat dst, double alpha, double beta, int typ) {
+    return catchException([&] {
+        cv::normalize(*src, *dst, alpha, beta, typ);
+    });
+}
+
 double Norm(Mat src1, int normType) {
     return cv::norm(*src1, normType);
 }
 
+OpenCVResult NormE(Mat src1, int normType, double* res) {
+    return catchException([&] {
+        *res = cv::norm(*src1, normType);
+    });
+}
+
 double NormWithMats(Mat src1, Mat src2, int normType) {
     return cv::norm(*src1, *src2, normType);
 }
 
+OpenCVResult NormWithMatsE(Mat src1, Mat src2, int normType, double* res) {
+    return catchException([&] {
+        *res = cv::norm(*src1, *src2, normType);
+    });
+}
+
 void Mat_PerspectiveTransform(Mat src, Mat dst, Mat tm) {
     cv::perspectiveTransform(*src, *dst, *tm);
 }
 
+OpenCVResult Mat_PerspectiveTransformE(Mat src, Mat dst, Mat tm) {
+    return catchException([&] {
+        cv::perspectiveTransform(*src, *dst, *tm);
+    });
+}
+
+double Mat_PSNR(Mat src1, Mat src2) {
+    return cv::PSNR(*src1, *src2);
+}
+
+OpenCVResult Mat_PSNRE(Mat src1, Mat src2, double* res) {
+    return catchException([&] {
+        *res = cv::PSNR(*src1, *src2);
+    });
+}
+
+double Mat_PSNRWithParams(Mat src1, Mat src2, double r) {
+    return cv::PSNR(*src1, *src2, r);
+}
+
+OpenCVResult Mat_PSNRWithParamsE(Mat src1, Mat src2, double r, double* res) {
+    return catchException([&] {
+        *res = cv::PSNR(*src1, *src2, r);
+    });
+}
+
 bool Mat_Solve(Mat src1, Mat src2, Mat dst, int flags) {
     return cv::solve(*src1, *src2, *dst, flags);
 }
 
+OpenCVResult Mat_SolveE(Mat src1, Mat src2, Mat dst, int flags, bool* res) {
+    return catchException([&] {
+        *res = cv::solve(*src1, *src2, *dst, flags);
+    });
+}
+
 int Mat_SolveCubic(Mat coeffs, Mat roots) {
     return cv::solveCubic(*coeffs, *roots);
 }
 
+OpenCVResult Mat_SolveCubicE(Mat coeffs, Mat roots, int* res) {
+    return catchException([&] {
+        *res = cv::solveCubic(*coeffs, *roots);
+    });
+}
+
 double Mat_SolvePoly(Mat coeffs, Mat roots, int maxIters) {
     return cv::solvePoly(*coeffs, *roots, maxIters);
 }
 
+OpenCVResult Mat_SolvePolyE(Mat coeffs, Mat roots, int maxIters, double* res) {
+    return catchException([&] {
+        *res = cv::solvePoly(*coeffs, *roots, maxIters);
+    });
+}
+
 void Mat_Reduce(Mat src, Mat dst, int dim, int rType, int dType) {
     cv::reduce(*src, *dst, dim, rType, dType);
 }
 
+OpenCVResult Mat_ReduceE(Mat src, Mat dst, int dim, int rType, int dType) {
+    return catchException([&] {
+        cv::reduce(*src, *dst, dim, rType, dType);
+    });
+}
+
 void Mat_Repeat(Mat src, int nY, int nX, Mat dst) {
     cv::repeat(*src, nY, nX, *dst);
 }
 
+OpenCVResult Mat_RepeatE(Mat src, int nY, int nX, Mat dst) {
+    return catchException([&] {
+        cv::repeat(*src, nY, nX, *dst);
+    });
+}
+
 void Mat_ScaleAdd(Mat src1, double alpha, Mat src2, Mat dst) {
     cv::scaleAdd(*src1, alpha, *src2, *dst);
 }
 
+OpenCVResult Mat_ScaleAddE(Mat src1, double alpha, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::scaleAdd(*src1, alpha, *src2, *dst);
+    });
+}
+
 void Mat_SetIdentity(Mat src, double scalar) {
     cv::setIdentity(*src, scalar);
 }
 
+OpenCVResult Mat_SetIdentityE(Mat src, double scalar) {
+    return catchException([&] {
+        cv::setIdentity(*src, scalar);
+    });
+}
+
 void Mat_Sort(Mat src, Mat dst, int flags) {
     cv::sort(*src, *dst, flags);
 }
 
+OpenCVResult Mat_SortE(Mat src, Mat dst, int flags) {
+    return catchException([&] {
+        cv::sort(*src, *dst, flags);
+    });
+}
+
 void Mat_SortIdx(Mat src, Mat dst, int flags) {
     cv::sortIdx(*src, *dst, flags);
 }
 
+OpenCVResult Mat_SortIdxE(Mat src, Mat dst, int flags) {
+    return catchException([&] {
+        cv::sortIdx(*src, *dst, flags);
+    });
+}
+
 void Mat_Split(Mat src, struct Mats* mats) {
     std::vector<cv::Mat> channels;
     cv::split(*src, channels);
@@ -715,10 +1656,88 @@ void Mat_Split(Mat src, struct Mats* mats) {
     mats->length = (int)channels.size();
 }
 
+OpenCVResult Mat_SplitE(Mat src, struct Mats* mats) {
+    return catchException([&] {
+        std::vector<cv::Mat> channels;
+        cv::split(*src, channels);
+        mats->mats = new Mat[channels.size()];
+
+        for (size_t i = 0; i < channels.size(); ++i) {
+            mats->mats[i] = new cv::Mat(channels[i]);
+        }
+
+        mats->length = (int)channels.size();
+    });
+}
+
 void Mat_Subtract(Mat src1, Mat src2, Mat dst) {
     cv::subtract(*src1, *src2, *dst);
 }
 
+OpenCVResult Mat_SubtractE(Mat src1, Mat src2, Mat dst) {
+    return catchException([&] {
+        cv::subtract(*src1, *src2, *dst);
+    });
+}
+
+void Mat_SubtractWithParams(Mat src1, Mat src2, Mat dst, Mat mask, int dtype) {
+    cv::subtract(*src1, *src2, *dst, *mask, dtype);
+}
+
+OpenCVResult Mat_SubtractWithParamsE(Mat src1, Mat src2, Mat dst, Mat mask, int dtype) {
+    return catchException([&] {
+        cv::subtract(*src1, *src2, *dst, *mask, dtype);
+    });
+}
+
+void Mat_SubtractScalar(Mat src, Scalar value, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::subtract(*src, c_value, *dst);
+}
+
+OpenCVResult Mat_SubtractScalarE(Mat src, Scalar value, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::subtract(*src, c_value, *dst);
+    });
+}
+
+void Mat_SubtractScalarWithParams(Mat src, Scalar value, Mat dst, Mat mask, int dtype) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::subtract(*src, c_value, *dst, *mask, dtype);
+}
+
+OpenCVResult Mat_SubtractScalarWithParamsE(Mat src, Scalar value, Mat dst, Mat mask, int dtype) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::subtract(*src, c_value, *dst, *mask, dtype);
+    });
+}
+
+void Mat_SubtractFromScalar(Scalar value, Mat src, Mat dst) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::subtract(c_value, *src, *dst);
+}
+
+OpenCVResult Mat_SubtractFromScalarE(Scalar value, Mat src, Mat dst) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::subtract(c_value, *src, *dst);
+    });
+}
+
+void Mat_SubtractFromScalarWithParams(Scalar value, Mat src, Mat dst, Mat mask, int dtype) {
+    cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+    cv::subtract(c_value, *src, *dst, *mask, dtype);
+}
+
+OpenCVResult Mat_SubtractFromScalarWithParamsE(Scalar value, Mat src, Mat dst, Mat mask, int dtype) {
+    return catchException([&] {
+        cv::Scalar c_value(value.val1, value.val2, value.val3, value.val4);
+        cv::subtract(c_value, *src, *dst, *mask, dtype);
+    });
+}
+
 Scalar Mat_Trace(Mat src) {
     cv::Scalar c = cv::trace(*src);
     Scalar scal = Scalar();
@@ -729,26 +1748,68 @@ Scalar Mat_Trace(Mat src) {
     return scal;
 }
 
+OpenCVResult Mat_TraceE(Mat src, Scalar* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::trace(*src);
+        Scalar scal = Scalar();
+        scal.val1 = c.val[0];
+        scal.val2 = c.val[1];
+        scal.val3 = c.val[2];
+        scal.val4 = c.val[3];
+        *res = scal;
+    });
+}
+
 void Mat_Transform(Mat src, Mat dst, Mat tm) {
     cv::transform(*src, *dst, *tm);
 }
 
+OpenCVResult Mat_TransformE(Mat src, Mat dst, Mat tm) {
+    return catchException([&] {
+        cv::transform(*src, *dst, *tm);
+    });
+}
+
 void Mat_Transpose(Mat src, Mat dst) {
     cv::transpose(*src, *dst);
 }
 
+OpenCVResult Mat_TransposeE(Mat src, Mat dst) {
+    return catchException([&] {
+        cv::transpose(*src, *dst);
+    });
+}
+
 void Mat_PolarToCart(Mat magnitude, Mat degree, Mat x, Mat y, bool angleInDegrees) {
     cv::polarToCart(*magnitude, *degree, *x, *y, angleInDegrees);
 }
 
+OpenCVResult Mat_PolarToCartE(Mat magnitude, Mat degree, Mat x, Mat y, bool angleInDegrees) {
+    return catchException([&] {
+        cv::polarToCart(*magnitude, *degree, *x, *y, angleInDegrees);
+    });
+}
+
 void Mat_Pow(Mat src, double power, Mat dst) {
     cv::pow(*src, power, *dst);
 }
 
+OpenCVResult Mat_PowE(Mat src, double power, Mat dst) {
+    return catchException([&] {
+        cv::pow(*src, power, *dst);
+    });
+}
+
 void Mat_Phase(Mat x, Mat y, Mat angle, bool angleInDegrees) {
 	cv::phase(*x, *y, *angle, angleInDegrees);
 }
 
+OpenCVResult Mat_PhaseE(Mat x, Mat y, Mat angle, bool angleInDegrees) {
+    return catchException([&] {
+        cv::phase(*x, *y, *angle, angleInDegrees);
+    });
+}
+
 
 Scalar Mat_Sum(Mat src) {
     cv::Scalar c = cv::sum(*src);
@@ -760,6 +1821,18 @@ Scalar Mat_Sum(Mat src) {
     return scal;
 }
 
+OpenCVResult Mat_SumE(Mat src, Scalar* res) {
+    return catchException([&] {
+        cv::Scalar c = cv::sum(*src);
+        Scalar scal = Scalar();
+        scal.val1 = c.val[0];
+        scal.val2 = c.val[1];
+        scal.val3 = c.val[2];
+        scal.val4 = c.val[3];
+        *res = scal;
+    });
+}
+
 // TermCriteria_New creates a new TermCriteria
 TermCriteria TermCriteria_New(int typ, int maxCount, double epsilon) {
     return new cv::TermCriteria(typ, maxCount, epsilon);
@@ -792,6 +1865,10 @@ void Points_Close(Points ps) {
     delete[] ps.points;
 }
 
+void Points2f_Close(Points2f ps) {
+    delete[] ps.points;
+}
+
 void Point_Close(Point p) {}
 
 void Rects_Close(struct Rects rs) {
@@ -845,10 +1922,22 @@ Mat Mat_rowRange(Mat m,int startrow,int endrow) {
     return new cv::Mat(m->rowRange(startrow,endrow));
 }
 
+OpenCVResult Mat_rowRangeE(Mat m, int startrow, int endrow, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(m->rowRange(startrow, endrow));
+    });
+}
+
 Mat Mat_colRange(Mat m,int startrow,int endrow) {
     return new cv::Mat(m->colRange(startrow,endrow));
 }
 
+OpenCVResult Mat_colRangeE(Mat m, int startcol, int endcol, Mat* res) {
+    return catchException([&] {
+        *res = new cv::Mat(m->colRange(startcol, endcol));
+    });
+}
+
 PointVector PointVector_New() {
     return new std::vector< cv::Point >;
 }
@@ -965,6 +2054,12 @@ void RNG_Fill(RNG rng, Mat mat, int distType, double a, double b, bool saturateR
     rng->fill(*mat, distType, a, b, saturateRange);
 }
 
+OpenCVResult RNG_FillE(RNG rng, Mat mat, int distType, double a, double b, bool saturateRange) {
+    return catchException([&] {
+        rng->fill(*mat, distType, a, b, saturateRange);
+    });
+}
+
 double RNG_Gaussian(RNG rng, double sigma) {
     return rng->gaussian(sigma);
 }
@@ -979,20 +2074,48 @@ void RandN(Mat mat, Scalar mean, Scalar stddev) {
     cv::randn(*mat, m, s);
 }
 
+OpenCVResult RandNE(Mat mat, Scalar mean, Scalar stddev) {
+    return catchException([&] {
+        cv::Scalar m = cv::Scalar(mean.val1, mean.val2, mean.val3, mean.val4);
+        cv::Scalar s = cv::Scalar(stddev.val1, stddev.val2, stddev.val3, stddev.val4);
+        cv::randn(*mat, m, s);
+    });
+}
+
 void RandShuffle(Mat mat) {
     cv::randShuffle(*mat);
 }
 
+OpenCVResult RandShuffleE(Mat mat) {
+    return catchException([&] {
+        cv::randShuffle(*mat);
+    });
+}
+
 void RandShuffleWithParams(Mat mat, double iterFactor, RNG rng) {
     cv::randShuffle(*mat, iterFactor, rng);
 }
 
+OpenCVResult RandShuffleWithParamsE(Mat mat, double iterFactor, RNG rng) {
+    return catchException([&] {
+        cv::randShuffle(*mat, iterFactor, rng);
+    });
+}
+
 void RandU(Mat mat, Scalar low, Scalar high) {
     cv::Scalar l = cv::Scalar(low.val1, low.val2, low.val3, low.val4);
     cv::Scalar h = cv::Scalar(high.val1, high.val2, high.val3, high.val4);
     cv::randn(*mat, l, h);
 }
 
+OpenCVResult RandUE(Mat mat, Scalar low, Scalar high) {
+    return catchException([&] {
+        cv::Scalar l = cv::Scalar(low.val1, low.val2, low.val3, low.val4);
+        cv::Scalar h = cv::Scalar(high.val1, high.val2, high.val3, high.val4);
+        cv::randn(*mat, l, h);
+    });
+}
+
 void copyPointVectorToPoint2fVector(PointVector src, Point2fVector dest) {
     for (size_t i = 0; i < src->size(); i++) {
         dest->push_back(cv::Point2f(src->at(i).x, src->at(i).y));
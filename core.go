@@ -7,9 +7,11 @@ package gocv
 import "C"
 import (
 	"errors"
+	"fmt"
 	"image"
 	"image/color"
 	"reflect"
+	"sync"
 	"unsafe"
 )
 
@@ -166,8 +168,96 @@ type Point2f struct {
 	Y float32
 }
 
+// Point3f is a 3D point with float32 coordinates.
+type Point3f struct {
+	X float32
+	Y float32
+	Z float32
+}
+
 var ErrEmptyByteSlice = errors.New("empty byte array")
 
+// OpenCVErrorCode is the status code of an OpenCVError.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d1/d0d/namespacecv_1_1Error.html
+//
+type OpenCVErrorCode int
+
+const (
+	// StsError is an unknown error.
+	StsError OpenCVErrorCode = -2
+
+	// StsNoMem is an insufficient memory error.
+	StsNoMem OpenCVErrorCode = -4
+
+	// StsBadArg is a bad argument error.
+	StsBadArg OpenCVErrorCode = -5
+
+	// StsNullPtr is a null pointer error.
+	StsNullPtr OpenCVErrorCode = -27
+
+	// StsBadSize is an incorrect size of input array error.
+	StsBadSize OpenCVErrorCode = -201
+
+	// StsUnmatchedFormats is a formats of input and output arrays differ error.
+	StsUnmatchedFormats OpenCVErrorCode = -205
+
+	// StsBadFlag is a flag is wrong or not supported error.
+	StsBadFlag OpenCVErrorCode = -206
+
+	// StsUnmatchedSizes is a sizes of input and output arrays differ error.
+	StsUnmatchedSizes OpenCVErrorCode = -209
+
+	// StsUnsupportedFormat is an unsupported format error.
+	StsUnsupportedFormat OpenCVErrorCode = -210
+
+	// StsOutOfRange is a value out of range error.
+	StsOutOfRange OpenCVErrorCode = -211
+
+	// StsAssert is an assertion failed error.
+	StsAssert OpenCVErrorCode = -215
+)
+
+// OpenCVError is a cv::Exception raised by OpenCV, that is returned by
+// the functions with an E suffix instead of aborting the process.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d1/dee/classcv_1_1Exception.html
+//
+type OpenCVError struct {
+	Code OpenCVErrorCode
+	Func string
+	File string
+	Line int
+	Msg  string
+}
+
+// Error returns the error message in the same format as cv::Exception.
+func (e *OpenCVError) Error() string {
+	return fmt.Sprintf("%s:%d: error: (%d) %s in function '%s'", e.File, e.Line, e.Code, e.Msg, e.Func)
+}
+
+// toError converts the result of a function with an E suffix to an
+// *OpenCVError, or nil if no exception was raised.
+func toError(r C.OpenCVResult) error {
+	if r.msg == nil {
+		return nil
+	}
+
+	err := &OpenCVError{
+		Code: OpenCVErrorCode(r.code),
+		Func: C.GoString(r._func),
+		File: C.GoString(r.file),
+		Line: int(r.line),
+		Msg:  C.GoString(r.msg),
+	}
+	C.free(unsafe.Pointer(r._func))
+	C.free(unsafe.Pointer(r.file))
+	C.free(unsafe.Pointer(r.msg))
+	return err
+}
+
 // Mat represents an n-dimensional dense numerical single-channel
 // or multi-channel array. It can be used to store real or complex-valued
 // vectors and matrices, grayscale or color images, voxel volumes,
@@ -181,6 +271,9 @@ type Mat struct {
 
 	// Non-nil if Mat was created with a []byte (using NewMatFromBytes()). Nil otherwise.
 	d []byte
+
+	// Non-nil if Mat was created while Mat finalizers were enabled. Nil otherwise.
+	r *matRef
 }
 
 // NewMat returns a new empty Mat.
@@ -193,6 +286,15 @@ func NewMatWithSize(rows int, cols int, mt MatType) Mat {
 	return newMat(C.Mat_NewWithSize(C.int(rows), C.int(cols), C.int(mt)))
 }
 
+// NewMatWithSizeE is the same as NewMatWithSize, but returns an error if OpenCV raises an exception.
+func NewMatWithSizeE(rows int, cols int, mt MatType) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_NewWithSizeE(C.int(rows), C.int(cols), C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // NewMatWithSizes returns a new multidimensional Mat with a specific size and type.
 func NewMatWithSizes(sizes []int, mt MatType) Mat {
 	sizesArray := make([]C.int, len(sizes))
@@ -207,6 +309,27 @@ func NewMatWithSizes(sizes []int, mt MatType) Mat {
 	return newMat(C.Mat_NewWithSizes(sizesIntVector, C.int(mt)))
 }
 
+// NewMatWithSizesE is the same as NewMatWithSizes, but returns an error if OpenCV raises an exception.
+func NewMatWithSizesE(sizes []int, mt MatType) (Mat, error) {
+	sizesArray := make([]C.int, len(sizes))
+	for i, s := range sizes {
+		sizesArray[i] = C.int(s)
+	}
+
+	sizesIntVector := C.IntVector{
+		length: C.int(len(sizes)),
+	}
+	if len(sizes) > 0 {
+		sizesIntVector.val = (*C.int)(&sizesArray[0])
+	}
+
+	var res C.Mat
+	if err := toError(C.Mat_NewWithSizesE(sizesIntVector, C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // NewMatWithSizesWithScalar returns a new multidimensional Mat with a specific size, type and scalar value.
 func NewMatWithSizesWithScalar(sizes []int, mt MatType, s Scalar) Mat {
 	csizes := []C.int{}
@@ -227,6 +350,32 @@ func NewMatWithSizesWithScalar(sizes []int, mt MatType, s Scalar) Mat {
 	return newMat(C.Mat_NewWithSizesFromScalar(sizesVector, C.int(mt), sVal))
 }
 
+// NewMatWithSizesWithScalarE is the same as NewMatWithSizesWithScalar, but returns an error if OpenCV raises an exception.
+func NewMatWithSizesWithScalarE(sizes []int, mt MatType, s Scalar) (Mat, error) {
+	csizes := []C.int{}
+	for _, v := range sizes {
+		csizes = append(csizes, C.int(v))
+	}
+	sizesVector := C.struct_IntVector{}
+	if len(csizes) > 0 {
+		sizesVector.val = (*C.int)(&csizes[0])
+	}
+	sizesVector.length = (C.int)(len(csizes))
+
+	sVal := C.struct_Scalar{
+		val1: C.double(s.Val1),
+		val2: C.double(s.Val2),
+		val3: C.double(s.Val3),
+		val4: C.double(s.Val4),
+	}
+
+	var res C.Mat
+	if err := toError(C.Mat_NewWithSizesFromScalarE(sizesVector, C.int(mt), sVal, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // NewMatWithSizesWithScalar returns a new multidimensional Mat with a specific size, type and preexisting data.
 func NewMatWithSizesFromBytes(sizes []int, mt MatType, data []byte) (Mat, error) {
 	cBytes, err := toByteArray(data)
@@ -257,6 +406,22 @@ func NewMatFromScalar(s Scalar, mt MatType) Mat {
 	return newMat(C.Mat_NewFromScalar(sVal, C.int(mt)))
 }
 
+// NewMatFromScalarE is the same as NewMatFromScalar, but returns an error if OpenCV raises an exception.
+func NewMatFromScalarE(s Scalar, mt MatType) (Mat, error) {
+	sVal := C.struct_Scalar{
+		val1: C.double(s.Val1),
+		val2: C.double(s.Val2),
+		val3: C.double(s.Val3),
+		val4: C.double(s.Val4),
+	}
+
+	var res C.Mat
+	if err := toError(C.Mat_NewFromScalarE(sVal, C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // NewMatWithSizeFromScalar returns a new Mat for a specific Scala value with a specific size and type
 // This simplifies creation of specific color filters or creating Mats of specific colors and sizes
 func NewMatWithSizeFromScalar(s Scalar, rows int, cols int, mt MatType) Mat {
@@ -270,6 +435,22 @@ func NewMatWithSizeFromScalar(s Scalar, rows int, cols int, mt MatType) Mat {
 	return newMat(C.Mat_NewWithSizeFromScalar(sVal, C.int(rows), C.int(cols), C.int(mt)))
 }
 
+// NewMatWithSizeFromScalarE is the same as NewMatWithSizeFromScalar, but returns an error if OpenCV raises an exception.
+func NewMatWithSizeFromScalarE(s Scalar, rows int, cols int, mt MatType) (Mat, error) {
+	sVal := C.struct_Scalar{
+		val1: C.double(s.Val1),
+		val2: C.double(s.Val2),
+		val3: C.double(s.Val3),
+		val4: C.double(s.Val4),
+	}
+
+	var res C.Mat
+	if err := toError(C.Mat_NewWithSizeFromScalarE(sVal, C.int(rows), C.int(cols), C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // NewMatFromBytes returns a new Mat with a specific size and type, initialized from a []byte.
 func NewMatFromBytes(rows int, cols int, mt MatType, data []byte) (Mat, error) {
 	cBytes, err := toByteArray(data)
@@ -289,6 +470,38 @@ func NewMatFromBytes(rows int, cols int, mt MatType, data []byte) (Mat, error) {
 	return mat, nil
 }
 
+// ErrInvalidStep is returned by NewMatFromBytesWithStep when the step or
+// the size of the data do not match the size and type of the Mat.
+var ErrInvalidStep = errors.New("invalid step or data size")
+
+// NewMatFromBytesWithStep returns a new Mat with a specific size and type,
+// that references data without copying it. step is the number of bytes
+// between the start of consecutive rows, which may include padding, such as
+// the stride of an image plane.
+//
+// data must not be modified or released while the Mat is in use.
+func NewMatFromBytesWithStep(rows int, cols int, mt MatType, data []byte, step int) (Mat, error) {
+	depth := int(mt & 7)
+	if depth >= len(matViewDepthSizes) || rows <= 0 || cols <= 0 {
+		return Mat{}, ErrInvalidStep
+	}
+	rowSize := cols * (int(mt>>3) + 1) * int(matViewDepthSizes[depth])
+	if step < rowSize || len(data) < (rows-1)*step+rowSize {
+		return Mat{}, ErrInvalidStep
+	}
+
+	cBytes, err := toByteArray(data)
+	if err != nil {
+		return Mat{}, err
+	}
+	mat := newMat(C.Mat_NewFromBytesWithStep(C.int(rows), C.int(cols), C.int(mt), *cBytes, C.int(step)))
+
+	// Store a reference to the backing data slice, like NewMatFromBytes.
+	mat.d = data
+
+	return mat, nil
+}
+
 // Returns an identity matrix of the specified size and type.
 //
 // The method returns a Matlab-style identity matrix initializer, similarly to Mat::zeros. Similarly to Mat::ones.
@@ -298,6 +511,15 @@ func Eye(rows int, cols int, mt MatType) Mat {
 	return newMat(C.Eye(C.int(rows), C.int(cols), C.int(mt)))
 }
 
+// EyeE is the same as Eye, but returns an error if OpenCV raises an exception.
+func EyeE(rows int, cols int, mt MatType) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.EyeE(C.int(rows), C.int(cols), C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // Returns a zero array of the specified size and type.
 //
 // The method returns a Matlab-style zero array initializer.
@@ -307,6 +529,15 @@ func Zeros(rows int, cols int, mt MatType) Mat {
 	return newMat(C.Zeros(C.int(rows), C.int(cols), C.int(mt)))
 }
 
+// ZerosE is the same as Zeros, but returns an error if OpenCV raises an exception.
+func ZerosE(rows int, cols int, mt MatType) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.ZerosE(C.int(rows), C.int(cols), C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // Returns an array of all 1's of the specified size and type.
 //
 // The method returns a Matlab-style 1's array initializer
@@ -316,6 +547,15 @@ func Ones(rows int, cols int, mt MatType) Mat {
 	return newMat(C.Ones(C.int(rows), C.int(cols), C.int(mt)))
 }
 
+// OnesE is the same as Ones, but returns an error if OpenCV raises an exception.
+func OnesE(rows int, cols int, mt MatType) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.OnesE(C.int(rows), C.int(cols), C.int(mt), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // FromPtr returns a new Mat with a specific size and type, initialized from a Mat Ptr.
 func (m *Mat) FromPtr(rows int, cols int, mt MatType, prow int, pcol int) (Mat, error) {
 	return newMat(C.Mat_FromPtr(m.p, C.int(rows), C.int(cols), C.int(mt), C.int(prow), C.int(pcol))), nil
@@ -346,6 +586,15 @@ func (m *Mat) Clone() Mat {
 	return newMat(C.Mat_Clone(m.p))
 }
 
+// CloneE is the same as Clone, but returns an error if OpenCV raises an exception.
+func (m *Mat) CloneE() (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_CloneE(m.p, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // CopyTo copies Mat into destination Mat.
 //
 // For further details, please see:
@@ -356,6 +605,11 @@ func (m *Mat) CopyTo(dst *Mat) {
 	return
 }
 
+// CopyToE is the same as CopyTo, but returns an error if OpenCV raises an exception.
+func (m *Mat) CopyToE(dst *Mat) error {
+	return toError(C.Mat_CopyToE(m.p, dst.p))
+}
+
 // CopyToWithMask copies Mat into destination Mat after applying the mask Mat.
 //
 // For further details, please see:
@@ -366,6 +620,11 @@ func (m *Mat) CopyToWithMask(dst *Mat, mask Mat) {
 	return
 }
 
+// CopyToWithMaskE is the same as CopyToWithMask, but returns an error if OpenCV raises an exception.
+func (m *Mat) CopyToWithMaskE(dst *Mat, mask Mat) error {
+	return toError(C.Mat_CopyToWithMaskE(m.p, dst.p, mask.p))
+}
+
 // ConvertTo converts Mat into destination Mat.
 //
 // For further details, please see:
@@ -376,11 +635,21 @@ func (m *Mat) ConvertTo(dst *Mat, mt MatType) {
 	return
 }
 
+// ConvertToE is the same as ConvertTo, but returns an error if OpenCV raises an exception.
+func (m *Mat) ConvertToE(dst *Mat, mt MatType) error {
+	return toError(C.Mat_ConvertToE(m.p, dst.p, C.int(mt)))
+}
+
 func (m *Mat) ConvertToWithParams(dst *Mat, mt MatType, alpha, beta float32) {
 	C.Mat_ConvertToWithParams(m.p, dst.p, C.int(mt), C.float(alpha), C.float(beta))
 	return
 }
 
+// ConvertToWithParamsE is the same as ConvertToWithParams, but returns an error if OpenCV raises an exception.
+func (m *Mat) ConvertToWithParamsE(dst *Mat, mt MatType, alpha, beta float32) error {
+	return toError(C.Mat_ConvertToWithParamsE(m.p, dst.p, C.int(mt), C.float(alpha), C.float(beta)))
+}
+
 // Total returns the total number of array elements.
 //
 // For further details, please see:
@@ -400,17 +669,7 @@ func (m *Mat) Size() (dims []int) {
 	C.Mat_Size(m.p, &cdims)
 	defer C.IntVector_Close(cdims)
 
-	h := &reflect.SliceHeader{
-		Data: uintptr(unsafe.Pointer(cdims.val)),
-		Len:  int(cdims.length),
-		Cap:  int(cdims.length),
-	}
-	pdims := *(*[]C.int)(unsafe.Pointer(h))
-
-	for i := 0; i < int(cdims.length); i++ {
-		dims = append(dims, int(pdims[i]))
-	}
-	return
+	return toGoInts(cdims)
 }
 
 // ToBytes copies the underlying Mat data to a byte array.
@@ -564,6 +823,22 @@ func (m *Mat) Region(rio image.Rectangle) Mat {
 	return newMat(C.Mat_Region(m.p, cRect))
 }
 
+// RegionE is the same as Region, but returns an error if OpenCV raises an exception.
+func (m *Mat) RegionE(rio image.Rectangle) (Mat, error) {
+	cRect := C.struct_Rect{
+		x:      C.int(rio.Min.X),
+		y:      C.int(rio.Min.Y),
+		width:  C.int(rio.Size().X),
+		height: C.int(rio.Size().Y),
+	}
+
+	var res C.Mat
+	if err := toError(C.Mat_RegionE(m.p, cRect, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // Reshape changes the shape and/or the number of channels of a 2D matrix without copying the data.
 //
 // For further details, please see:
@@ -573,6 +848,15 @@ func (m *Mat) Reshape(cn int, rows int) Mat {
 	return newMat(C.Mat_Reshape(m.p, C.int(cn), C.int(rows)))
 }
 
+// ReshapeE is the same as Reshape, but returns an error if OpenCV raises an exception.
+func (m *Mat) ReshapeE(cn int, rows int) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_ReshapeE(m.p, C.int(cn), C.int(rows), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // ConvertFp16 converts a Mat to half-precision floating point.
 //
 // For further details, please see:
@@ -582,6 +866,15 @@ func (m *Mat) ConvertFp16() Mat {
 	return newMat(C.Mat_ConvertFp16(m.p))
 }
 
+// ConvertFp16E is the same as ConvertFp16, but returns an error if OpenCV raises an exception.
+func (m *Mat) ConvertFp16E() (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_ConvertFp16E(m.p, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // Mean calculates the mean value M of array elements, independently for each channel, and return it as Scalar
 // For further details, please see:
 // https://docs.opencv.org/master/d2/de8/group__core__array.html#ga191389f8a0e58180bb13a727782cd461
@@ -591,6 +884,15 @@ func (m *Mat) Mean() Scalar {
 	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4))
 }
 
+// MeanE is the same as Mean, but returns an error if OpenCV raises an exception.
+func (m *Mat) MeanE() (Scalar, error) {
+	var s C.Scalar
+	if err := toError(C.Mat_MeanE(m.p, &s)); err != nil {
+		return Scalar{}, err
+	}
+	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4)), nil
+}
+
 // MeanWithMask calculates the mean value M of array elements,independently for each channel,
 // and returns it as Scalar vector while applying the mask.
 // https://docs.opencv.org/master/d2/de8/group__core__array.html#ga191389f8a0e58180bb13a727782cd461
@@ -600,6 +902,15 @@ func (m *Mat) MeanWithMask(mask Mat) Scalar {
 	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4))
 }
 
+// MeanWithMaskE is the same as MeanWithMask, but returns an error if OpenCV raises an exception.
+func (m *Mat) MeanWithMaskE(mask Mat) (Scalar, error) {
+	var s C.Scalar
+	if err := toError(C.Mat_MeanWithMaskE(m.p, mask.p, &s)); err != nil {
+		return Scalar{}, err
+	}
+	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4)), nil
+}
+
 // Sqrt calculates a square root of array elements.
 //
 // For further details, please see:
@@ -609,6 +920,15 @@ func (m *Mat) Sqrt() Mat {
 	return newMat(C.Mat_Sqrt(m.p))
 }
 
+// SqrtE is the same as Sqrt, but returns an error if OpenCV raises an exception.
+func (m *Mat) SqrtE() (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_SqrtE(m.p, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // Sum calculates the per-channel pixel sum of an image.
 //
 // For further details, please see:
@@ -619,6 +939,15 @@ func (m *Mat) Sum() Scalar {
 	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4))
 }
 
+// SumE is the same as Sum, but returns an error if OpenCV raises an exception.
+func (m *Mat) SumE() (Scalar, error) {
+	var s C.Scalar
+	if err := toError(C.Mat_SumE(m.p, &s)); err != nil {
+		return Scalar{}, err
+	}
+	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4)), nil
+}
+
 // PatchNaNs converts NaN's to zeros.
 //
 // For further details, please see:
@@ -628,6 +957,11 @@ func (m *Mat) PatchNaNs() {
 	C.Mat_PatchNaNs(m.p)
 }
 
+// PatchNaNsE is the same as PatchNaNs, but returns an error if OpenCV raises an exception.
+func (m *Mat) PatchNaNsE() error {
+	return toError(C.Mat_PatchNaNsE(m.p))
+}
+
 // LUT performs a look-up table transform of an array.
 //
 // The function LUT fills the output array with values from the look-up table.
@@ -639,6 +973,11 @@ func LUT(src, wbLUT Mat, dst *Mat) {
 	C.LUT(src.p, wbLUT.p, dst.p)
 }
 
+// LUTE is the same as LUT, but returns an error if OpenCV raises an exception.
+func LUTE(src, wbLUT Mat, dst *Mat) error {
+	return toError(C.LUTE(src.p, wbLUT.p, dst.p))
+}
+
 // Rows returns the number of rows for this Mat.
 func (m *Mat) Rows() int {
 	return int(C.Mat_Rows(m.p))
@@ -664,6 +1003,20 @@ func (m *Mat) Step() int {
 	return int(C.Mat_Step(m.p))
 }
 
+// Steps returns the number of bytes between consecutive elements of each
+// dimension of the Mat.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d63/classcv_1_1Mat.html
+//
+func (m *Mat) Steps() (steps []int) {
+	csteps := C.IntVector{}
+	C.Mat_Steps(m.p, &csteps)
+	defer C.IntVector_Close(csteps)
+
+	return toGoInts(csteps)
+}
+
 // GetUCharAt returns a value from a specific row/col
 // in this Mat expecting it to be of type uchar aka CV_8U.
 func (m *Mat) GetUCharAt(row int, col int) uint8 {
@@ -748,6 +1101,18 @@ func (m *Mat) SetTo(s Scalar) {
 	C.Mat_SetTo(m.p, sVal)
 }
 
+// SetToE is the same as SetTo, but returns an error if OpenCV raises an exception.
+func (m *Mat) SetToE(s Scalar) error {
+	sVal := C.struct_Scalar{
+		val1: C.double(s.Val1),
+		val2: C.double(s.Val2),
+		val3: C.double(s.Val3),
+		val4: C.double(s.Val4),
+	}
+
+	return toError(C.Mat_SetToE(m.p, sVal))
+}
+
 // SetUCharAt sets a value at a specific row/col
 // in this Mat expecting it to be of type uchar aka CV_8U.
 func (m *Mat) SetUCharAt(row int, col int, val uint8) {
@@ -826,59 +1191,117 @@ func (m *Mat) AddUChar(val uint8) {
 	C.Mat_AddUChar(m.p, C.uint8_t(val))
 }
 
+// AddUCharE is the same as AddUChar, but returns an error if OpenCV raises an exception.
+func (m *Mat) AddUCharE(val uint8) error {
+	return toError(C.Mat_AddUCharE(m.p, C.uint8_t(val)))
+}
+
 // SubtractUChar subtracts a uchar value from each element in the Mat. Performs a
 // mat -= val operation.
 func (m *Mat) SubtractUChar(val uint8) {
 	C.Mat_SubtractUChar(m.p, C.uint8_t(val))
 }
 
+// SubtractUCharE is the same as SubtractUChar, but returns an error if OpenCV raises an exception.
+func (m *Mat) SubtractUCharE(val uint8) error {
+	return toError(C.Mat_SubtractUCharE(m.p, C.uint8_t(val)))
+}
+
 // MultiplyUChar multiplies each element in the Mat by a uint value. Performs a
 // mat *= val operation.
 func (m *Mat) MultiplyUChar(val uint8) {
 	C.Mat_MultiplyUChar(m.p, C.uint8_t(val))
 }
 
+// MultiplyUCharE is the same as MultiplyUChar, but returns an error if OpenCV raises an exception.
+func (m *Mat) MultiplyUCharE(val uint8) error {
+	return toError(C.Mat_MultiplyUCharE(m.p, C.uint8_t(val)))
+}
+
 // DivideUChar divides each element in the Mat by a uint value. Performs a
 // mat /= val operation.
 func (m *Mat) DivideUChar(val uint8) {
 	C.Mat_DivideUChar(m.p, C.uint8_t(val))
 }
 
+// DivideUCharE is the same as DivideUChar, but returns an error if OpenCV raises an exception.
+func (m *Mat) DivideUCharE(val uint8) error {
+	return toError(C.Mat_DivideUCharE(m.p, C.uint8_t(val)))
+}
+
 // AddFloat adds a float value to each element in the Mat. Performs a
 // mat += val operation.
 func (m *Mat) AddFloat(val float32) {
 	C.Mat_AddFloat(m.p, C.float(val))
 }
 
+// AddFloatE is the same as AddFloat, but returns an error if OpenCV raises an exception.
+func (m *Mat) AddFloatE(val float32) error {
+	return toError(C.Mat_AddFloatE(m.p, C.float(val)))
+}
+
 // SubtractFloat subtracts a float value from each element in the Mat. Performs a
 // mat -= val operation.
 func (m *Mat) SubtractFloat(val float32) {
 	C.Mat_SubtractFloat(m.p, C.float(val))
 }
 
+// SubtractFloatE is the same as SubtractFloat, but returns an error if OpenCV raises an exception.
+func (m *Mat) SubtractFloatE(val float32) error {
+	return toError(C.Mat_SubtractFloatE(m.p, C.float(val)))
+}
+
 // MultiplyFloat multiplies each element in the Mat by a float value. Performs a
 // mat *= val operation.
 func (m *Mat) MultiplyFloat(val float32) {
 	C.Mat_MultiplyFloat(m.p, C.float(val))
 }
 
+// MultiplyFloatE is the same as MultiplyFloat, but returns an error if OpenCV raises an exception.
+func (m *Mat) MultiplyFloatE(val float32) error {
+	return toError(C.Mat_MultiplyFloatE(m.p, C.float(val)))
+}
+
 // DivideFloat divides each element in the Mat by a float value. Performs a
 // mat /= val operation.
 func (m *Mat) DivideFloat(val float32) {
 	C.Mat_DivideFloat(m.p, C.float(val))
 }
 
+// DivideFloatE is the same as DivideFloat, but returns an error if OpenCV raises an exception.
+func (m *Mat) DivideFloatE(val float32) error {
+	return toError(C.Mat_DivideFloatE(m.p, C.float(val)))
+}
+
 // MultiplyMatrix multiplies matrix (m*x)
 func (m *Mat) MultiplyMatrix(x Mat) Mat {
 	return newMat(C.Mat_MultiplyMatrix(m.p, x.p))
 }
 
+// MultiplyMatrixE is the same as MultiplyMatrix, but returns an error if OpenCV raises an exception.
+func (m *Mat) MultiplyMatrixE(x Mat) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_MultiplyMatrixE(m.p, x.p, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // T  transpose matrix
 // https://docs.opencv.org/4.1.2/d3/d63/classcv_1_1Mat.html#aaa428c60ccb6d8ea5de18f63dfac8e11
 func (m *Mat) T() Mat {
 	return newMat(C.Mat_T(m.p))
 }
 
+// TE is the same as T, but returns an error if OpenCV raises an exception.
+func (m *Mat) TE() (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_TE(m.p, &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // AbsDiff calculates the per-element absolute difference between two arrays
 // or between an array and a scalar.
 //
@@ -889,6 +1312,41 @@ func AbsDiff(src1, src2 Mat, dst *Mat) {
 	C.Mat_AbsDiff(src1.p, src2.p, dst.p)
 }
 
+// AbsDiffE is the same as AbsDiff, but returns an error if OpenCV raises an exception.
+func AbsDiffE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_AbsDiffE(src1.p, src2.p, dst.p))
+}
+
+// AbsDiffScalar calculates the per-element absolute difference between an array
+// and a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga6fef31bc8c4071cbc114a758a2b79c14
+//
+func AbsDiffScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_AbsDiffScalar(src.p, toCScalar(s), dst.p)
+}
+
+// AbsDiffScalarE is the same as AbsDiffScalar, but returns an error if OpenCV raises an exception.
+func AbsDiffScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_AbsDiffScalarE(src.p, toCScalar(s), dst.p))
+}
+
+// Abs calculates the per-element absolute value of an array of any depth,
+// saturating the result as the cv::abs matrix expression does.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d1/d10/classcv_1_1MatExpr.html
+//
+func Abs(src Mat, dst *Mat) {
+	C.Mat_Abs(src.p, dst.p)
+}
+
+// AbsE is the same as Abs, but returns an error if OpenCV raises an exception.
+func AbsE(src Mat, dst *Mat) error {
+	return toError(C.Mat_AbsE(src.p, dst.p))
+}
+
 // Add calculates the per-element sum of two arrays or an array and a scalar.
 //
 // For further details, please see:
@@ -898,6 +1356,57 @@ func Add(src1, src2 Mat, dst *Mat) {
 	C.Mat_Add(src1.p, src2.p, dst.p)
 }
 
+// AddE is the same as Add, but returns an error if OpenCV raises an exception.
+func AddE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_AddE(src1.p, src2.p, dst.p))
+}
+
+// AddWithParams calculates the per-element sum of two arrays, only for the
+// elements where mask is non-zero. mask may be an empty Mat, and a dtype of -1
+// produces a dst with the same depth as the inputs.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga10ac1bfb180e2cfda1701d06c24fdbd6
+//
+func AddWithParams(src1, src2 Mat, dst *Mat, mask Mat, dtype MatType) {
+	C.Mat_AddWithParams(src1.p, src2.p, dst.p, mask.p, C.int(dtype))
+}
+
+// AddWithParamsE is the same as AddWithParams, but returns an error if OpenCV raises an exception.
+func AddWithParamsE(src1, src2 Mat, dst *Mat, mask Mat, dtype MatType) error {
+	return toError(C.Mat_AddWithParamsE(src1.p, src2.p, dst.p, mask.p, C.int(dtype)))
+}
+
+// AddScalar calculates the per-element sum of an array and a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga10ac1bfb180e2cfda1701d06c24fdbd6
+//
+func AddScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_AddScalar(src.p, toCScalar(s), dst.p)
+}
+
+// AddScalarE is the same as AddScalar, but returns an error if OpenCV raises an exception.
+func AddScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_AddScalarE(src.p, toCScalar(s), dst.p))
+}
+
+// AddScalarWithParams calculates the per-element sum of an array and a scalar,
+// only for the elements where mask is non-zero. mask may be an empty Mat, and
+// a dtype of -1 produces a dst with the same depth as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga10ac1bfb180e2cfda1701d06c24fdbd6
+//
+func AddScalarWithParams(src Mat, s Scalar, dst *Mat, mask Mat, dtype MatType) {
+	C.Mat_AddScalarWithParams(src.p, toCScalar(s), dst.p, mask.p, C.int(dtype))
+}
+
+// AddScalarWithParamsE is the same as AddScalarWithParams, but returns an error if OpenCV raises an exception.
+func AddScalarWithParamsE(src Mat, s Scalar, dst *Mat, mask Mat, dtype MatType) error {
+	return toError(C.Mat_AddScalarWithParamsE(src.p, toCScalar(s), dst.p, mask.p, C.int(dtype)))
+}
+
 // AddWeighted calculates the weighted sum of two arrays.
 //
 // For further details, please see:
@@ -908,6 +1417,11 @@ func AddWeighted(src1 Mat, alpha float64, src2 Mat, beta float64, gamma float64,
 		src2.p, C.double(beta), C.double(gamma), dst.p)
 }
 
+// AddWeightedE is the same as AddWeighted, but returns an error if OpenCV raises an exception.
+func AddWeightedE(src1 Mat, alpha float64, src2 Mat, beta float64, gamma float64, dst *Mat) error {
+	return toError(C.Mat_AddWeightedE(src1.p, C.double(alpha), src2.p, C.double(beta), C.double(gamma), dst.p))
+}
+
 // BitwiseAnd computes bitwise conjunction of the two arrays (dst = src1 & src2).
 // Calculates the per-element bit-wise conjunction of two arrays
 // or an array and a scalar.
@@ -919,6 +1433,11 @@ func BitwiseAnd(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_BitwiseAnd(src1.p, src2.p, dst.p)
 }
 
+// BitwiseAndE is the same as BitwiseAnd, but returns an error if OpenCV raises an exception.
+func BitwiseAndE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_BitwiseAndE(src1.p, src2.p, dst.p))
+}
+
 // BitwiseAndWithMask computes bitwise conjunction of the two arrays (dst = src1 & src2).
 // Calculates the per-element bit-wise conjunction of two arrays
 // or an array and a scalar. It has an additional parameter for a mask.
@@ -930,6 +1449,11 @@ func BitwiseAndWithMask(src1 Mat, src2 Mat, dst *Mat, mask Mat) {
 	C.Mat_BitwiseAndWithMask(src1.p, src2.p, dst.p, mask.p)
 }
 
+// BitwiseAndWithMaskE is the same as BitwiseAndWithMask, but returns an error if OpenCV raises an exception.
+func BitwiseAndWithMaskE(src1 Mat, src2 Mat, dst *Mat, mask Mat) error {
+	return toError(C.Mat_BitwiseAndWithMaskE(src1.p, src2.p, dst.p, mask.p))
+}
+
 // BitwiseNot inverts every bit of an array.
 //
 // For further details, please see:
@@ -939,6 +1463,11 @@ func BitwiseNot(src1 Mat, dst *Mat) {
 	C.Mat_BitwiseNot(src1.p, dst.p)
 }
 
+// BitwiseNotE is the same as BitwiseNot, but returns an error if OpenCV raises an exception.
+func BitwiseNotE(src1 Mat, dst *Mat) error {
+	return toError(C.Mat_BitwiseNotE(src1.p, dst.p))
+}
+
 // BitwiseNotWithMask inverts every bit of an array. It has an additional parameter for a mask.
 //
 // For further details, please see:
@@ -948,6 +1477,11 @@ func BitwiseNotWithMask(src1 Mat, dst *Mat, mask Mat) {
 	C.Mat_BitwiseNotWithMask(src1.p, dst.p, mask.p)
 }
 
+// BitwiseNotWithMaskE is the same as BitwiseNotWithMask, but returns an error if OpenCV raises an exception.
+func BitwiseNotWithMaskE(src1 Mat, dst *Mat, mask Mat) error {
+	return toError(C.Mat_BitwiseNotWithMaskE(src1.p, dst.p, mask.p))
+}
+
 // BitwiseOr calculates the per-element bit-wise disjunction of two arrays
 // or an array and a scalar.
 //
@@ -958,6 +1492,11 @@ func BitwiseOr(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_BitwiseOr(src1.p, src2.p, dst.p)
 }
 
+// BitwiseOrE is the same as BitwiseOr, but returns an error if OpenCV raises an exception.
+func BitwiseOrE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_BitwiseOrE(src1.p, src2.p, dst.p))
+}
+
 // BitwiseOrWithMask calculates the per-element bit-wise disjunction of two arrays
 // or an array and a scalar. It has an additional parameter for a mask.
 //
@@ -968,6 +1507,11 @@ func BitwiseOrWithMask(src1 Mat, src2 Mat, dst *Mat, mask Mat) {
 	C.Mat_BitwiseOrWithMask(src1.p, src2.p, dst.p, mask.p)
 }
 
+// BitwiseOrWithMaskE is the same as BitwiseOrWithMask, but returns an error if OpenCV raises an exception.
+func BitwiseOrWithMaskE(src1 Mat, src2 Mat, dst *Mat, mask Mat) error {
+	return toError(C.Mat_BitwiseOrWithMaskE(src1.p, src2.p, dst.p, mask.p))
+}
+
 // BitwiseXor calculates the per-element bit-wise "exclusive or" operation
 // on two arrays or an array and a scalar.
 //
@@ -978,6 +1522,11 @@ func BitwiseXor(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_BitwiseXor(src1.p, src2.p, dst.p)
 }
 
+// BitwiseXorE is the same as BitwiseXor, but returns an error if OpenCV raises an exception.
+func BitwiseXorE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_BitwiseXorE(src1.p, src2.p, dst.p))
+}
+
 // BitwiseXorWithMask calculates the per-element bit-wise "exclusive or" operation
 // on two arrays or an array and a scalar. It has an additional parameter for a mask.
 //
@@ -988,6 +1537,11 @@ func BitwiseXorWithMask(src1 Mat, src2 Mat, dst *Mat, mask Mat) {
 	C.Mat_BitwiseXorWithMask(src1.p, src2.p, dst.p, mask.p)
 }
 
+// BitwiseXorWithMaskE is the same as BitwiseXorWithMask, but returns an error if OpenCV raises an exception.
+func BitwiseXorWithMaskE(src1 Mat, src2 Mat, dst *Mat, mask Mat) error {
+	return toError(C.Mat_BitwiseXorWithMaskE(src1.p, src2.p, dst.p, mask.p))
+}
+
 // BatchDistance is a naive nearest neighbor finder.
 //
 // For further details, please see:
@@ -997,6 +1551,11 @@ func BatchDistance(src1 Mat, src2 Mat, dist Mat, dtype MatType, nidx Mat, normTy
 	C.Mat_BatchDistance(src1.p, src2.p, dist.p, C.int(dtype), nidx.p, C.int(normType), C.int(K), mask.p, C.int(update), C.bool(crosscheck))
 }
 
+// BatchDistanceE is the same as BatchDistance, but returns an error if OpenCV raises an exception.
+func BatchDistanceE(src1 Mat, src2 Mat, dist Mat, dtype MatType, nidx Mat, normType NormType, K int, mask Mat, update int, crosscheck bool) error {
+	return toError(C.Mat_BatchDistanceE(src1.p, src2.p, dist.p, C.int(dtype), nidx.p, C.int(normType), C.int(K), mask.p, C.int(update), C.bool(crosscheck)))
+}
+
 // BorderInterpolate computes the source location of an extrapolated pixel.
 //
 // For further details, please see:
@@ -1007,6 +1566,13 @@ func BorderInterpolate(p int, len int, borderType CovarFlags) int {
 	return int(ret)
 }
 
+// BorderInterpolateE is the same as BorderInterpolate, but returns an error if OpenCV raises an exception.
+func BorderInterpolateE(p int, len int, borderType CovarFlags) (int, error) {
+	var ret C.int
+	err := toError(C.Mat_BorderInterpolateE(C.int(p), C.int(len), C.int(borderType), &ret))
+	return int(ret), err
+}
+
 // CovarFlags are the covariation flags used by functions such as BorderInterpolate.
 //
 // For further details, please see:
@@ -1043,6 +1609,11 @@ func CalcCovarMatrix(samples Mat, covar *Mat, mean *Mat, flags CovarFlags, ctype
 	C.Mat_CalcCovarMatrix(samples.p, covar.p, mean.p, C.int(flags), C.int(ctype))
 }
 
+// CalcCovarMatrixE is the same as CalcCovarMatrix, but returns an error if OpenCV raises an exception.
+func CalcCovarMatrixE(samples Mat, covar *Mat, mean *Mat, flags CovarFlags, ctype MatType) error {
+	return toError(C.Mat_CalcCovarMatrixE(samples.p, covar.p, mean.p, C.int(flags), C.int(ctype)))
+}
+
 // CartToPolar calculates the magnitude and angle of 2D vectors.
 //
 // For further details, please see:
@@ -1052,6 +1623,11 @@ func CartToPolar(x Mat, y Mat, magnitude *Mat, angle *Mat, angleInDegrees bool)
 	C.Mat_CartToPolar(x.p, y.p, magnitude.p, angle.p, C.bool(angleInDegrees))
 }
 
+// CartToPolarE is the same as CartToPolar, but returns an error if OpenCV raises an exception.
+func CartToPolarE(x Mat, y Mat, magnitude *Mat, angle *Mat, angleInDegrees bool) error {
+	return toError(C.Mat_CartToPolarE(x.p, y.p, magnitude.p, angle.p, C.bool(angleInDegrees)))
+}
+
 // CheckRange checks every element of an input array for invalid values.
 //
 // For further details, please see:
@@ -1061,6 +1637,13 @@ func CheckRange(src Mat) bool {
 	return bool(C.Mat_CheckRange(src.p))
 }
 
+// CheckRangeE is the same as CheckRange, but returns an error if OpenCV raises an exception.
+func CheckRangeE(src Mat) (bool, error) {
+	var res C.bool
+	err := toError(C.Mat_CheckRangeE(src.p, &res))
+	return bool(res), err
+}
+
 // Compare performs the per-element comparison of two arrays
 // or an array and scalar value.
 //
@@ -1071,6 +1654,27 @@ func Compare(src1 Mat, src2 Mat, dst *Mat, ct CompareType) {
 	C.Mat_Compare(src1.p, src2.p, dst.p, C.int(ct))
 }
 
+// CompareE is the same as Compare, but returns an error if OpenCV raises an exception.
+func CompareE(src1 Mat, src2 Mat, dst *Mat, ct CompareType) error {
+	return toError(C.Mat_CompareE(src1.p, src2.p, dst.p, C.int(ct)))
+}
+
+// CompareScalar performs the per-element comparison of a single-channel array
+// and a value. dst is a MatTypeCV8U Mat with 255 where the comparison holds
+// and 0 elsewhere.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga303cfb72acf8cbb36d884650c09a3a97
+//
+func CompareScalar(src Mat, val float64, dst *Mat, ct CompareType) {
+	C.Mat_CompareScalar(src.p, C.double(val), dst.p, C.int(ct))
+}
+
+// CompareScalarE is the same as CompareScalar, but returns an error if OpenCV raises an exception.
+func CompareScalarE(src Mat, val float64, dst *Mat, ct CompareType) error {
+	return toError(C.Mat_CompareScalarE(src.p, C.double(val), dst.p, C.int(ct)))
+}
+
 // CountNonZero counts non-zero array elements.
 //
 // For further details, please see:
@@ -1080,6 +1684,13 @@ func CountNonZero(src Mat) int {
 	return int(C.Mat_CountNonZero(src.p))
 }
 
+// CountNonZeroE is the same as CountNonZero, but returns an error if OpenCV raises an exception.
+func CountNonZeroE(src Mat) (int, error) {
+	var res C.int
+	err := toError(C.Mat_CountNonZeroE(src.p, &res))
+	return int(res), err
+}
+
 // CompleteSymm copies the lower or the upper half of a square matrix to its another half.
 //
 // For further details, please see:
@@ -1089,6 +1700,11 @@ func CompleteSymm(m Mat, lowerToUpper bool) {
 	C.Mat_CompleteSymm(m.p, C.bool(lowerToUpper))
 }
 
+// CompleteSymmE is the same as CompleteSymm, but returns an error if OpenCV raises an exception.
+func CompleteSymmE(m Mat, lowerToUpper bool) error {
+	return toError(C.Mat_CompleteSymmE(m.p, C.bool(lowerToUpper)))
+}
+
 // ConvertScaleAbs scales, calculates absolute values, and converts the result to 8-bit.
 //
 // For further details, please see:
@@ -1098,6 +1714,11 @@ func ConvertScaleAbs(src Mat, dst *Mat, alpha float64, beta float64) {
 	C.Mat_ConvertScaleAbs(src.p, dst.p, C.double(alpha), C.double(beta))
 }
 
+// ConvertScaleAbsE is the same as ConvertScaleAbs, but returns an error if OpenCV raises an exception.
+func ConvertScaleAbsE(src Mat, dst *Mat, alpha float64, beta float64) error {
+	return toError(C.Mat_ConvertScaleAbsE(src.p, dst.p, C.double(alpha), C.double(beta)))
+}
+
 // CopyMakeBorder forms a border around an image (applies padding).
 //
 // For further details, please see:
@@ -1115,6 +1736,18 @@ func CopyMakeBorder(src Mat, dst *Mat, top int, bottom int, left int, right int,
 	C.Mat_CopyMakeBorder(src.p, dst.p, C.int(top), C.int(bottom), C.int(left), C.int(right), C.int(bt), cValue)
 }
 
+// CopyMakeBorderE is the same as CopyMakeBorder, but returns an error if OpenCV raises an exception.
+func CopyMakeBorderE(src Mat, dst *Mat, top int, bottom int, left int, right int, bt BorderType, value color.RGBA) error {
+	cValue := C.struct_Scalar{
+		val1: C.double(value.B),
+		val2: C.double(value.G),
+		val3: C.double(value.R),
+		val4: C.double(value.A),
+	}
+
+	return toError(C.Mat_CopyMakeBorderE(src.p, dst.p, C.int(top), C.int(bottom), C.int(left), C.int(right), C.int(bt), cValue))
+}
+
 // DftFlags represents a DFT or DCT flag.
 //
 // For further details, please see:
@@ -1162,6 +1795,11 @@ func DCT(src Mat, dst *Mat, flags DftFlags) {
 	C.Mat_DCT(src.p, dst.p, C.int(flags))
 }
 
+// DCTE is the same as DCT, but returns an error if OpenCV raises an exception.
+func DCTE(src Mat, dst *Mat, flags DftFlags) error {
+	return toError(C.Mat_DCTE(src.p, dst.p, C.int(flags)))
+}
+
 // Determinant returns the determinant of a square floating-point matrix.
 //
 // For further details, please see:
@@ -1171,6 +1809,13 @@ func Determinant(src Mat) float64 {
 	return float64(C.Mat_Determinant(src.p))
 }
 
+// DeterminantE is the same as Determinant, but returns an error if OpenCV raises an exception.
+func DeterminantE(src Mat) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_DeterminantE(src.p, &res))
+	return float64(res), err
+}
+
 // DFT performs a forward or inverse Discrete Fourier Transform (DFT)
 // of a 1D or 2D floating-point array.
 //
@@ -1181,6 +1826,11 @@ func DFT(src Mat, dst *Mat, flags DftFlags) {
 	C.Mat_DFT(src.p, dst.p, C.int(flags))
 }
 
+// DFTE is the same as DFT, but returns an error if OpenCV raises an exception.
+func DFTE(src Mat, dst *Mat, flags DftFlags) error {
+	return toError(C.Mat_DFTE(src.p, dst.p, C.int(flags)))
+}
+
 // Divide performs the per-element division
 // on two arrays or an array and a scalar.
 //
@@ -1191,6 +1841,72 @@ func Divide(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_Divide(src1.p, src2.p, dst.p)
 }
 
+// DivideE is the same as Divide, but returns an error if OpenCV raises an exception.
+func DivideE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_DivideE(src1.p, src2.p, dst.p))
+}
+
+// DivideWithParams performs the per-element division of two arrays, as
+// src1 * scale / src2. A dtype of -1 produces a dst with the same depth as
+// the inputs.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga6db555d30115642fedae0cda05604874
+//
+func DivideWithParams(src1 Mat, src2 Mat, dst *Mat, scale float64, dtype MatType) {
+	C.Mat_DivideWithParams(src1.p, src2.p, dst.p, C.double(scale), C.int(dtype))
+}
+
+// DivideWithParamsE is the same as DivideWithParams, but returns an error if OpenCV raises an exception.
+func DivideWithParamsE(src1 Mat, src2 Mat, dst *Mat, scale float64, dtype MatType) error {
+	return toError(C.Mat_DivideWithParamsE(src1.p, src2.p, dst.p, C.double(scale), C.int(dtype)))
+}
+
+// DivideScalar performs the per-element division of an array by a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga6db555d30115642fedae0cda05604874
+//
+func DivideScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_DivideScalar(src.p, toCScalar(s), dst.p)
+}
+
+// DivideScalarE is the same as DivideScalar, but returns an error if OpenCV raises an exception.
+func DivideScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_DivideScalarE(src.p, toCScalar(s), dst.p))
+}
+
+// DivideScalarWithParams performs the per-element division of an array by a
+// scalar, as src * scale / s. A dtype of -1 produces a dst with the same depth
+// as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga6db555d30115642fedae0cda05604874
+//
+func DivideScalarWithParams(src Mat, s Scalar, dst *Mat, scale float64, dtype MatType) {
+	C.Mat_DivideScalarWithParams(src.p, toCScalar(s), dst.p, C.double(scale), C.int(dtype))
+}
+
+// DivideScalarWithParamsE is the same as DivideScalarWithParams, but returns an error if OpenCV raises an exception.
+func DivideScalarWithParamsE(src Mat, s Scalar, dst *Mat, scale float64, dtype MatType) error {
+	return toError(C.Mat_DivideScalarWithParamsE(src.p, toCScalar(s), dst.p, C.double(scale), C.int(dtype)))
+}
+
+// DivideFromScalar performs the per-element division of a scalar by an array,
+// as scale / src. A dtype of -1 produces a dst with the same depth as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html
+//
+func DivideFromScalar(scale float64, src Mat, dst *Mat, dtype MatType) {
+	C.Mat_DivideFromScalar(C.double(scale), src.p, dst.p, C.int(dtype))
+}
+
+// DivideFromScalarE is the same as DivideFromScalar, but returns an error if OpenCV raises an exception.
+func DivideFromScalarE(scale float64, src Mat, dst *Mat, dtype MatType) error {
+	return toError(C.Mat_DivideFromScalarE(C.double(scale), src.p, dst.p, C.int(dtype)))
+}
+
 // Eigen calculates eigenvalues and eigenvectors of a symmetric matrix.
 //
 // For further details, please see:
@@ -1201,6 +1917,13 @@ func Eigen(src Mat, eigenvalues *Mat, eigenvectors *Mat) bool {
 	return bool(ret)
 }
 
+// EigenE is the same as Eigen, but returns an error if OpenCV raises an exception.
+func EigenE(src Mat, eigenvalues *Mat, eigenvectors *Mat) (bool, error) {
+	var ret C.bool
+	err := toError(C.Mat_EigenE(src.p, eigenvalues.p, eigenvectors.p, &ret))
+	return bool(ret), err
+}
+
 // EigenNonSymmetric calculates eigenvalues and eigenvectors of a non-symmetric matrix (real eigenvalues only).
 //
 // For further details, please see:
@@ -1210,6 +1933,11 @@ func EigenNonSymmetric(src Mat, eigenvalues *Mat, eigenvectors *Mat) {
 	C.Mat_EigenNonSymmetric(src.p, eigenvalues.p, eigenvectors.p)
 }
 
+// EigenNonSymmetricE is the same as EigenNonSymmetric, but returns an error if OpenCV raises an exception.
+func EigenNonSymmetricE(src Mat, eigenvalues *Mat, eigenvectors *Mat) error {
+	return toError(C.Mat_EigenNonSymmetricE(src.p, eigenvalues.p, eigenvectors.p))
+}
+
 // Exp calculates the exponent of every array element.
 //
 // For further details, please see:
@@ -1219,6 +1947,11 @@ func Exp(src Mat, dst *Mat) {
 	C.Mat_Exp(src.p, dst.p)
 }
 
+// ExpE is the same as Exp, but returns an error if OpenCV raises an exception.
+func ExpE(src Mat, dst *Mat) error {
+	return toError(C.Mat_ExpE(src.p, dst.p))
+}
+
 // ExtractChannel extracts a single channel from src (coi is 0-based index).
 //
 // For further details, please see:
@@ -1228,6 +1961,11 @@ func ExtractChannel(src Mat, dst *Mat, coi int) {
 	C.Mat_ExtractChannel(src.p, dst.p, C.int(coi))
 }
 
+// ExtractChannelE is the same as ExtractChannel, but returns an error if OpenCV raises an exception.
+func ExtractChannelE(src Mat, dst *Mat, coi int) error {
+	return toError(C.Mat_ExtractChannelE(src.p, dst.p, C.int(coi)))
+}
+
 // FindNonZero returns the list of locations of non-zero pixels.
 //
 // For further details, please see:
@@ -1237,6 +1975,11 @@ func FindNonZero(src Mat, idx *Mat) {
 	C.Mat_FindNonZero(src.p, idx.p)
 }
 
+// FindNonZeroE is the same as FindNonZero, but returns an error if OpenCV raises an exception.
+func FindNonZeroE(src Mat, idx *Mat) error {
+	return toError(C.Mat_FindNonZeroE(src.p, idx.p))
+}
+
 // Flip flips a 2D array around horizontal(0), vertical(1), or both axes(-1).
 //
 // For further details, please see:
@@ -1246,6 +1989,11 @@ func Flip(src Mat, dst *Mat, flipCode int) {
 	C.Mat_Flip(src.p, dst.p, C.int(flipCode))
 }
 
+// FlipE is the same as Flip, but returns an error if OpenCV raises an exception.
+func FlipE(src Mat, dst *Mat, flipCode int) error {
+	return toError(C.Mat_FlipE(src.p, dst.p, C.int(flipCode)))
+}
+
 // Gemm performs generalized matrix multiplication.
 //
 // For further details, please see:
@@ -1255,6 +2003,11 @@ func Gemm(src1, src2 Mat, alpha float64, src3 Mat, beta float64, dst *Mat, flags
 	C.Mat_Gemm(src1.p, src2.p, C.double(alpha), src3.p, C.double(beta), dst.p, C.int(flags))
 }
 
+// GemmE is the same as Gemm, but returns an error if OpenCV raises an exception.
+func GemmE(src1, src2 Mat, alpha float64, src3 Mat, beta float64, dst *Mat, flags int) error {
+	return toError(C.Mat_GemmE(src1.p, src2.p, C.double(alpha), src3.p, C.double(beta), dst.p, C.int(flags)))
+}
+
 // GetOptimalDFTSize returns the optimal Discrete Fourier Transform (DFT) size
 // for a given vector size.
 //
@@ -1274,6 +2027,11 @@ func Hconcat(src1, src2 Mat, dst *Mat) {
 	C.Mat_Hconcat(src1.p, src2.p, dst.p)
 }
 
+// HconcatE is the same as Hconcat, but returns an error if OpenCV raises an exception.
+func HconcatE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_HconcatE(src1.p, src2.p, dst.p))
+}
+
 // Vconcat applies vertical concatenation to given matrices.
 //
 // For further details, please see:
@@ -1283,6 +2041,11 @@ func Vconcat(src1, src2 Mat, dst *Mat) {
 	C.Mat_Vconcat(src1.p, src2.p, dst.p)
 }
 
+// VconcatE is the same as Vconcat, but returns an error if OpenCV raises an exception.
+func VconcatE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_VconcatE(src1.p, src2.p, dst.p))
+}
+
 // RotateFlag for image rotation
 //
 //
@@ -1307,6 +2070,11 @@ func Rotate(src Mat, dst *Mat, code RotateFlag) {
 	C.Rotate(src.p, dst.p, C.int(code))
 }
 
+// RotateE is the same as Rotate, but returns an error if OpenCV raises an exception.
+func RotateE(src Mat, dst *Mat, code RotateFlag) error {
+	return toError(C.RotateE(src.p, dst.p, C.int(code)))
+}
+
 // IDCT calculates the inverse Discrete Cosine Transform of a 1D or 2D array.
 //
 // For further details, please see:
@@ -1316,6 +2084,11 @@ func IDCT(src Mat, dst *Mat, flags int) {
 	C.Mat_Idct(src.p, dst.p, C.int(flags))
 }
 
+// IDCTE is the same as IDCT, but returns an error if OpenCV raises an exception.
+func IDCTE(src Mat, dst *Mat, flags int) error {
+	return toError(C.Mat_IdctE(src.p, dst.p, C.int(flags)))
+}
+
 // IDFT calculates the inverse Discrete Fourier Transform of a 1D or 2D array.
 //
 // For further details, please see:
@@ -1325,6 +2098,11 @@ func IDFT(src Mat, dst *Mat, flags, nonzeroRows int) {
 	C.Mat_Idft(src.p, dst.p, C.int(flags), C.int(nonzeroRows))
 }
 
+// IDFTE is the same as IDFT, but returns an error if OpenCV raises an exception.
+func IDFTE(src Mat, dst *Mat, flags, nonzeroRows int) error {
+	return toError(C.Mat_IdftE(src.p, dst.p, C.int(flags), C.int(nonzeroRows)))
+}
+
 // InRange checks if array elements lie between the elements of two Mat arrays.
 //
 // For further details, please see:
@@ -1334,6 +2112,11 @@ func InRange(src, lb, ub Mat, dst *Mat) {
 	C.Mat_InRange(src.p, lb.p, ub.p, dst.p)
 }
 
+// InRangeE is the same as InRange, but returns an error if OpenCV raises an exception.
+func InRangeE(src, lb, ub Mat, dst *Mat) error {
+	return toError(C.Mat_InRangeE(src.p, lb.p, ub.p, dst.p))
+}
+
 // InRangeWithScalar checks if array elements lie between the elements of two Scalars
 //
 // For further details, please see:
@@ -1357,6 +2140,25 @@ func InRangeWithScalar(src Mat, lb, ub Scalar, dst *Mat) {
 	C.Mat_InRangeWithScalar(src.p, lbVal, ubVal, dst.p)
 }
 
+// InRangeWithScalarE is the same as InRangeWithScalar, but returns an error if OpenCV raises an exception.
+func InRangeWithScalarE(src Mat, lb, ub Scalar, dst *Mat) error {
+	lbVal := C.struct_Scalar{
+		val1: C.double(lb.Val1),
+		val2: C.double(lb.Val2),
+		val3: C.double(lb.Val3),
+		val4: C.double(lb.Val4),
+	}
+
+	ubVal := C.struct_Scalar{
+		val1: C.double(ub.Val1),
+		val2: C.double(ub.Val2),
+		val3: C.double(ub.Val3),
+		val4: C.double(ub.Val4),
+	}
+
+	return toError(C.Mat_InRangeWithScalarE(src.p, lbVal, ubVal, dst.p))
+}
+
 // InsertChannel inserts a single channel to dst (coi is 0-based index)
 // (it replaces channel i with another in dst).
 //
@@ -1367,6 +2169,11 @@ func InsertChannel(src Mat, dst *Mat, coi int) {
 	C.Mat_InsertChannel(src.p, dst.p, C.int(coi))
 }
 
+// InsertChannelE is the same as InsertChannel, but returns an error if OpenCV raises an exception.
+func InsertChannelE(src Mat, dst *Mat, coi int) error {
+	return toError(C.Mat_InsertChannelE(src.p, dst.p, C.int(coi)))
+}
+
 // Invert finds the inverse or pseudo-inverse of a matrix.
 //
 // For further details, please see:
@@ -1377,6 +2184,13 @@ func Invert(src Mat, dst *Mat, flags SolveDecompositionFlags) float64 {
 	return float64(ret)
 }
 
+// InvertE is the same as Invert, but returns an error if OpenCV raises an exception.
+func InvertE(src Mat, dst *Mat, flags SolveDecompositionFlags) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_InvertE(src.p, dst.p, C.int(flags), &res))
+	return float64(res), err
+}
+
 // KMeansFlags for kmeans center selection
 //
 // For further details, please see:
@@ -1403,6 +2217,13 @@ func KMeans(data Mat, k int, bestLabels *Mat, criteria TermCriteria, attempts in
 	return float64(ret)
 }
 
+// KMeansE is the same as KMeans, but returns an error if OpenCV raises an exception.
+func KMeansE(data Mat, k int, bestLabels *Mat, criteria TermCriteria, attempts int, flags KMeansFlags, centers *Mat) (float64, error) {
+	var ret C.double
+	err := toError(C.KMeansE(data.p, C.int(k), bestLabels.p, criteria.p, C.int(attempts), C.int(flags), centers.p, &ret))
+	return float64(ret), err
+}
+
 // KMeansPoints finds centers of clusters and groups input samples around the clusters.
 //
 // For further details, please see:
@@ -1413,6 +2234,88 @@ func KMeansPoints(points PointVector, k int, bestLabels *Mat, criteria TermCrite
 	return float64(ret)
 }
 
+// KMeansPointsE is the same as KMeansPoints, but returns an error if OpenCV raises an exception.
+func KMeansPointsE(points PointVector, k int, bestLabels *Mat, criteria TermCriteria, attempts int, flags KMeansFlags, centers *Mat) (float64, error) {
+	var ret C.double
+	err := toError(C.KMeansPointsE(points.p, C.int(k), bestLabels.p, criteria.p, C.int(attempts), C.int(flags), centers.p, &ret))
+	return float64(ret), err
+}
+
+// partitionPredicates holds the Go predicates of the Partition calls in
+// progress, so that they can be looked up from the C++ callback by handle.
+var partitionPredicates = struct {
+	sync.RWMutex
+	next int
+	fns  map[int]func(i, j int) bool
+}{fns: make(map[int]func(i, j int) bool)}
+
+//export goPartitionPredicate
+func goPartitionPredicate(predicate C.int, a, b C.int) C.bool {
+	partitionPredicates.RLock()
+	fn := partitionPredicates.fns[int(predicate)]
+	partitionPredicates.RUnlock()
+
+	return C.bool(fn(int(a), int(b)))
+}
+
+// Partition splits n elements into equivalence classes, using the
+// equivalence predicate to decide whether the elements at indices i and j
+// belong to the same class. Similar to sort.Slice, the predicate usually
+// closes over the slice being partitioned. It returns the zero-based class
+// label of every element and the number of classes.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d5/d38/group__core__cluster.html#ga2037c989e69b499c1aa271419f3a9b34
+//
+func Partition(n int, predicate func(i, j int) bool) (labels []int, nclasses int) {
+	if n <= 0 {
+		return []int{}, 0
+	}
+
+	partitionPredicates.Lock()
+	partitionPredicates.next++
+	handle := partitionPredicates.next
+	partitionPredicates.fns[handle] = predicate
+	partitionPredicates.Unlock()
+
+	defer func() {
+		partitionPredicates.Lock()
+		delete(partitionPredicates.fns, handle)
+		partitionPredicates.Unlock()
+	}()
+
+	cLabels := C.IntVector{}
+	nclasses = int(C.Partition(C.int(n), C.int(handle), &cLabels))
+	defer C.IntVector_Close(cLabels)
+
+	return toGoInts(cLabels), nclasses
+}
+
+// PartitionRects splits rects into equivalence classes using the given
+// predicate, for example to merge overlapping detections.
+// See Partition for details.
+func PartitionRects(rects []image.Rectangle, predicate func(a, b image.Rectangle) bool) (labels []int, nclasses int) {
+	return Partition(len(rects), func(i, j int) bool {
+		return predicate(rects[i], rects[j])
+	})
+}
+
+// PartitionPoints splits points into equivalence classes using the given
+// predicate. See Partition for details.
+func PartitionPoints(points []image.Point, predicate func(a, b image.Point) bool) (labels []int, nclasses int) {
+	return Partition(len(points), func(i, j int) bool {
+		return predicate(points[i], points[j])
+	})
+}
+
+// PartitionKeyPoints splits keypoints into equivalence classes using the
+// given predicate. See Partition for details.
+func PartitionKeyPoints(keypoints []KeyPoint, predicate func(a, b KeyPoint) bool) (labels []int, nclasses int) {
+	return Partition(len(keypoints), func(i, j int) bool {
+		return predicate(keypoints[i], keypoints[j])
+	})
+}
+
 // Log calculates the natural logarithm of every array element.
 //
 // For further details, please see:
@@ -1422,6 +2325,11 @@ func Log(src Mat, dst *Mat) {
 	C.Mat_Log(src.p, dst.p)
 }
 
+// LogE is the same as Log, but returns an error if OpenCV raises an exception.
+func LogE(src Mat, dst *Mat) error {
+	return toError(C.Mat_LogE(src.p, dst.p))
+}
+
 // Magnitude calculates the magnitude of 2D vectors.
 //
 // For further details, please see:
@@ -1431,6 +2339,29 @@ func Magnitude(x, y Mat, magnitude *Mat) {
 	C.Mat_Magnitude(x.p, y.p, magnitude.p)
 }
 
+// MagnitudeE is the same as Magnitude, but returns an error if OpenCV raises an exception.
+func MagnitudeE(x, y Mat, magnitude *Mat) error {
+	return toError(C.Mat_MagnitudeE(x.p, y.p, magnitude.p))
+}
+
+// Mahalanobis calculates the Mahalanobis distance between two vectors,
+// using the inverse covariance matrix icovar. The vectors and icovar must
+// have the same floating point depth, either CV32F or CV64F.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga4493aee129179459cbfc6064f051aa7d
+//
+func Mahalanobis(v1, v2, icovar Mat) float64 {
+	return float64(C.Mat_Mahalanobis(v1.p, v2.p, icovar.p))
+}
+
+// MahalanobisE is the same as Mahalanobis, but returns an error if OpenCV raises an exception.
+func MahalanobisE(v1, v2, icovar Mat) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_MahalanobisE(v1.p, v2.p, icovar.p, &res))
+	return float64(res), err
+}
+
 // Max calculates per-element maximum of two arrays or an array and a scalar.
 //
 // For further details, please see:
@@ -1440,6 +2371,25 @@ func Max(src1, src2 Mat, dst *Mat) {
 	C.Mat_Max(src1.p, src2.p, dst.p)
 }
 
+// MaxE is the same as Max, but returns an error if OpenCV raises an exception.
+func MaxE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_MaxE(src1.p, src2.p, dst.p))
+}
+
+// MaxScalar calculates the per-element maximum of an array and a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gacc40fa15eac0fb83f8ca70b7cc0b588d
+//
+func MaxScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_MaxScalar(src.p, toCScalar(s), dst.p)
+}
+
+// MaxScalarE is the same as MaxScalar, but returns an error if OpenCV raises an exception.
+func MaxScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_MaxScalarE(src.p, toCScalar(s), dst.p))
+}
+
 // MeanStdDev calculates a mean and standard deviation of array elements.
 //
 // For further details, please see:
@@ -1449,6 +2399,11 @@ func MeanStdDev(src Mat, dst *Mat, dstStdDev *Mat) {
 	C.Mat_MeanStdDev(src.p, dst.p, dstStdDev.p)
 }
 
+// MeanStdDevE is the same as MeanStdDev, but returns an error if OpenCV raises an exception.
+func MeanStdDevE(src Mat, dst *Mat, dstStdDev *Mat) error {
+	return toError(C.Mat_MeanStdDevE(src.p, dst.p, dstStdDev.p))
+}
+
 // Merge creates one multi-channel array out of several single-channel ones.
 //
 // For further details, please see:
@@ -1467,6 +2422,22 @@ func Merge(mv []Mat, dst *Mat) {
 	C.Mat_Merge(cMats, dst.p)
 }
 
+// MergeE is the same as Merge, but returns an error if OpenCV raises an exception.
+func MergeE(mv []Mat, dst *Mat) error {
+	cMatArray := make([]C.Mat, len(mv))
+	for i, r := range mv {
+		cMatArray[i] = r.p
+	}
+	cMats := C.struct_Mats{
+		length: C.int(len(mv)),
+	}
+	if len(mv) > 0 {
+		cMats.mats = (*C.Mat)(&cMatArray[0])
+	}
+
+	return toError(C.Mat_MergeE(cMats, dst.p))
+}
+
 // Min calculates per-element minimum of two arrays or an array and a scalar.
 //
 // For further details, please see:
@@ -1476,6 +2447,25 @@ func Min(src1, src2 Mat, dst *Mat) {
 	C.Mat_Min(src1.p, src2.p, dst.p)
 }
 
+// MinE is the same as Min, but returns an error if OpenCV raises an exception.
+func MinE(src1, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_MinE(src1.p, src2.p, dst.p))
+}
+
+// MinScalar calculates the per-element minimum of an array and a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga9af368f182ee76d0463d0d8d5330b764
+//
+func MinScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_MinScalar(src.p, toCScalar(s), dst.p)
+}
+
+// MinScalarE is the same as MinScalar, but returns an error if OpenCV raises an exception.
+func MinScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_MinScalarE(src.p, toCScalar(s), dst.p))
+}
+
 // MinMaxIdx finds the global minimum and maximum in an array.
 //
 // For further details, please see:
@@ -1489,7 +2479,21 @@ func MinMaxIdx(input Mat) (minVal, maxVal float32, minIdx, maxIdx int) {
 
 	C.Mat_MinMaxIdx(input.p, &cMinVal, &cMaxVal, &cMinIdx, &cMaxIdx)
 
-	return float32(cMinVal), float32(cMaxVal), int(minIdx), int(maxIdx)
+	return float32(cMinVal), float32(cMaxVal), int(minIdx), int(maxIdx)
+}
+
+// MinMaxIdxE is the same as MinMaxIdx, but returns an error if OpenCV raises an exception.
+func MinMaxIdxE(input Mat) (minVal, maxVal float32, minIdx, maxIdx int, err error) {
+	var cMinVal C.double
+	var cMaxVal C.double
+	var cMinIdx C.int
+	var cMaxIdx C.int
+
+	if err := toError(C.Mat_MinMaxIdxE(input.p, &cMinVal, &cMaxVal, &cMinIdx, &cMaxIdx)); err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	return float32(cMinVal), float32(cMaxVal), int(cMinIdx), int(cMaxIdx), nil
 }
 
 // MinMaxLoc finds the global minimum and maximum in an array.
@@ -1511,6 +2515,23 @@ func MinMaxLoc(input Mat) (minVal, maxVal float32, minLoc, maxLoc image.Point) {
 	return float32(cMinVal), float32(cMaxVal), minLoc, maxLoc
 }
 
+// MinMaxLocE is the same as MinMaxLoc, but returns an error if OpenCV raises an exception.
+func MinMaxLocE(input Mat) (minVal, maxVal float32, minLoc, maxLoc image.Point, err error) {
+	var cMinVal C.double
+	var cMaxVal C.double
+	var cMinLoc C.struct_Point
+	var cMaxLoc C.struct_Point
+
+	if err := toError(C.Mat_MinMaxLocE(input.p, &cMinVal, &cMaxVal, &cMinLoc, &cMaxLoc)); err != nil {
+		return 0, 0, image.Point{}, image.Point{}, err
+	}
+
+	minLoc = image.Pt(int(cMinLoc.x), int(cMinLoc.y))
+	maxLoc = image.Pt(int(cMaxLoc.x), int(cMaxLoc.y))
+
+	return float32(cMinVal), float32(cMaxVal), minLoc, maxLoc, nil
+}
+
 // Copies specified channels from input arrays to the specified channels of output arrays.
 //
 // For further details, please see:
@@ -1552,6 +2573,26 @@ func MixChannels(src []Mat, dst []Mat, fromTo []int) {
 	}
 }
 
+// MixChannelsE is the same as MixChannels, but returns an error if OpenCV raises an exception.
+func MixChannelsE(src []Mat, dst []Mat, fromTo []int) error {
+	cSrcMats := toCMats(src)
+	cDstMats := toCMats(dst)
+
+	cFromToArray := make([]C.int, len(fromTo))
+	for i, ft := range fromTo {
+		cFromToArray[i] = C.int(ft)
+	}
+
+	cFromToIntVector := C.IntVector{
+		length: C.int(len(fromTo)),
+	}
+	if len(fromTo) > 0 {
+		cFromToIntVector.val = (*C.int)(&cFromToArray[0])
+	}
+
+	return toError(C.Mat_MixChannelsE(cSrcMats, cDstMats, cFromToIntVector))
+}
+
 //Mulspectrums performs the per-element multiplication of two Fourier spectrums.
 //
 // For further details, please see:
@@ -1561,6 +2602,43 @@ func MulSpectrums(a Mat, b Mat, dst *Mat, flags DftFlags) {
 	C.Mat_MulSpectrums(a.p, b.p, dst.p, C.int(flags))
 }
 
+// MulSpectrumsE is the same as MulSpectrums, but returns an error if OpenCV raises an exception.
+func MulSpectrumsE(a Mat, b Mat, dst *Mat, flags DftFlags) error {
+	return toError(C.Mat_MulSpectrumsE(a.p, b.p, dst.p, C.int(flags)))
+}
+
+// MulTransposed calculates the product of a matrix and its transposition.
+// If ata is true, dst = src^T * src, otherwise dst = src * src^T.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gadc4e49f8f7a155044e3be1b9e3b270ab
+//
+func MulTransposed(src Mat, dst *Mat, ata bool) {
+	C.Mat_MulTransposed(src.p, dst.p, C.bool(ata))
+}
+
+// MulTransposedE is the same as MulTransposed, but returns an error if OpenCV raises an exception.
+func MulTransposedE(src Mat, dst *Mat, ata bool) error {
+	return toError(C.Mat_MulTransposedE(src.p, dst.p, C.bool(ata)))
+}
+
+// MulTransposedWithParams calculates the product of a matrix and its
+// transposition, after subtracting delta from src and multiplying the result
+// by scale. delta may be an empty Mat, and a dtype of -1 produces a dst with
+// the same depth as src (or CV32F if src has an integer depth).
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gadc4e49f8f7a155044e3be1b9e3b270ab
+//
+func MulTransposedWithParams(src Mat, dst *Mat, ata bool, delta Mat, scale float64, dtype MatType) {
+	C.Mat_MulTransposedWithParams(src.p, dst.p, C.bool(ata), delta.p, C.double(scale), C.int(dtype))
+}
+
+// MulTransposedWithParamsE is the same as MulTransposedWithParams, but returns an error if OpenCV raises an exception.
+func MulTransposedWithParamsE(src Mat, dst *Mat, ata bool, delta Mat, scale float64, dtype MatType) error {
+	return toError(C.Mat_MulTransposedWithParamsE(src.p, dst.p, C.bool(ata), delta.p, C.double(scale), C.int(dtype)))
+}
+
 // Multiply calculates the per-element scaled product of two arrays.
 // Both input arrays must be of the same size and the same type.
 //
@@ -1571,6 +2649,11 @@ func Multiply(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_Multiply(src1.p, src2.p, dst.p)
 }
 
+// MultiplyE is the same as Multiply, but returns an error if OpenCV raises an exception.
+func MultiplyE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_MultiplyE(src1.p, src2.p, dst.p))
+}
+
 // MultiplyWithParams calculates the per-element scaled product of two arrays.
 // Both input arrays must be of the same size and the same type.
 //
@@ -1581,6 +2664,40 @@ func MultiplyWithParams(src1 Mat, src2 Mat, dst *Mat, scale float64, dtype MatTy
 	C.Mat_MultiplyWithParams(src1.p, src2.p, dst.p, C.double(scale), C.int(dtype))
 }
 
+// MultiplyWithParamsE is the same as MultiplyWithParams, but returns an error if OpenCV raises an exception.
+func MultiplyWithParamsE(src1 Mat, src2 Mat, dst *Mat, scale float64, dtype MatType) error {
+	return toError(C.Mat_MultiplyWithParamsE(src1.p, src2.p, dst.p, C.double(scale), C.int(dtype)))
+}
+
+// MultiplyScalar calculates the per-element product of an array and a scalar.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga979d898a58d7f61c53003e162e7ad89f
+//
+func MultiplyScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_MultiplyScalar(src.p, toCScalar(s), dst.p)
+}
+
+// MultiplyScalarE is the same as MultiplyScalar, but returns an error if OpenCV raises an exception.
+func MultiplyScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_MultiplyScalarE(src.p, toCScalar(s), dst.p))
+}
+
+// MultiplyScalarWithParams calculates the per-element scaled product of an
+// array and a scalar. A dtype of -1 produces a dst with the same depth as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga979d898a58d7f61c53003e162e7ad89f
+//
+func MultiplyScalarWithParams(src Mat, s Scalar, dst *Mat, scale float64, dtype MatType) {
+	C.Mat_MultiplyScalarWithParams(src.p, toCScalar(s), dst.p, C.double(scale), C.int(dtype))
+}
+
+// MultiplyScalarWithParamsE is the same as MultiplyScalarWithParams, but returns an error if OpenCV raises an exception.
+func MultiplyScalarWithParamsE(src Mat, s Scalar, dst *Mat, scale float64, dtype MatType) error {
+	return toError(C.Mat_MultiplyScalarWithParamsE(src.p, toCScalar(s), dst.p, C.double(scale), C.int(dtype)))
+}
+
 // NormType for normalization operations.
 //
 // For further details, please see:
@@ -1626,6 +2743,11 @@ func Normalize(src Mat, dst *Mat, alpha float64, beta float64, typ NormType) {
 	C.Mat_Normalize(src.p, dst.p, C.double(alpha), C.double(beta), C.int(typ))
 }
 
+// NormalizeE is the same as Normalize, but returns an error if OpenCV raises an exception.
+func NormalizeE(src Mat, dst *Mat, alpha float64, beta float64, typ NormType) error {
+	return toError(C.Mat_NormalizeE(src.p, dst.p, C.double(alpha), C.double(beta), C.int(typ)))
+}
+
 // Norm calculates the absolute norm of an array.
 //
 // For further details, please see:
@@ -1635,6 +2757,13 @@ func Norm(src1 Mat, normType NormType) float64 {
 	return float64(C.Norm(src1.p, C.int(normType)))
 }
 
+// NormE is the same as Norm, but returns an error if OpenCV raises an exception.
+func NormE(src1 Mat, normType NormType) (float64, error) {
+	var res C.double
+	err := toError(C.NormE(src1.p, C.int(normType), &res))
+	return float64(res), err
+}
+
 // Norm calculates the absolute difference/relative norm of two arrays.
 //
 // For further details, please see:
@@ -1644,6 +2773,13 @@ func NormWithMats(src1 Mat, src2 Mat, normType NormType) float64 {
 	return float64(C.NormWithMats(src1.p, src2.p, C.int(normType)))
 }
 
+// NormWithMatsE is the same as NormWithMats, but returns an error if OpenCV raises an exception.
+func NormWithMatsE(src1 Mat, src2 Mat, normType NormType) (float64, error) {
+	var res C.double
+	err := toError(C.NormWithMatsE(src1.p, src2.p, C.int(normType), &res))
+	return float64(res), err
+}
+
 // PerspectiveTransform performs the perspective matrix transformation of vectors.
 //
 // For further details, please see:
@@ -1653,6 +2789,47 @@ func PerspectiveTransform(src Mat, dst *Mat, tm Mat) {
 	C.Mat_PerspectiveTransform(src.p, dst.p, tm.p)
 }
 
+// PerspectiveTransformE is the same as PerspectiveTransform, but returns an error if OpenCV raises an exception.
+func PerspectiveTransformE(src Mat, dst *Mat, tm Mat) error {
+	return toError(C.Mat_PerspectiveTransformE(src.p, dst.p, tm.p))
+}
+
+// PSNR computes the Peak Signal-to-Noise Ratio (PSNR) image quality metric,
+// in decibels, between two arrays of the same size and type. The maximum
+// pixel value is assumed to be 255, as for CV8U images.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga07aaf34ae31d226b1b847d8bcff3698f
+//
+func PSNR(src1, src2 Mat) float64 {
+	return float64(C.Mat_PSNR(src1.p, src2.p))
+}
+
+// PSNRE is the same as PSNR, but returns an error if OpenCV raises an exception.
+func PSNRE(src1, src2 Mat) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_PSNRE(src1.p, src2.p, &res))
+	return float64(res), err
+}
+
+// PSNRWithParams computes the Peak Signal-to-Noise Ratio (PSNR) image quality
+// metric using r as the maximum pixel value, e.g. 65535 for CV16U images or
+// 1.0 for normalized floating point images.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga07aaf34ae31d226b1b847d8bcff3698f
+//
+func PSNRWithParams(src1, src2 Mat, r float64) float64 {
+	return float64(C.Mat_PSNRWithParams(src1.p, src2.p, C.double(r)))
+}
+
+// PSNRWithParamsE is the same as PSNRWithParams, but returns an error if OpenCV raises an exception.
+func PSNRWithParamsE(src1, src2 Mat, r float64) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_PSNRWithParamsE(src1.p, src2.p, C.double(r), &res))
+	return float64(res), err
+}
+
 // TermCriteriaType for TermCriteria.
 //
 // For further details, please see:
@@ -1705,6 +2882,13 @@ func Solve(src1 Mat, src2 Mat, dst *Mat, flags SolveDecompositionFlags) bool {
 	return bool(C.Mat_Solve(src1.p, src2.p, dst.p, C.int(flags)))
 }
 
+// SolveE is the same as Solve, but returns an error if OpenCV raises an exception.
+func SolveE(src1 Mat, src2 Mat, dst *Mat, flags SolveDecompositionFlags) (bool, error) {
+	var res C.bool
+	err := toError(C.Mat_SolveE(src1.p, src2.p, dst.p, C.int(flags), &res))
+	return bool(res), err
+}
+
 // SolveCubic finds the real roots of a cubic equation.
 //
 // For further details, please see:
@@ -1714,6 +2898,13 @@ func SolveCubic(coeffs Mat, roots *Mat) int {
 	return int(C.Mat_SolveCubic(coeffs.p, roots.p))
 }
 
+// SolveCubicE is the same as SolveCubic, but returns an error if OpenCV raises an exception.
+func SolveCubicE(coeffs Mat, roots *Mat) (int, error) {
+	var res C.int
+	err := toError(C.Mat_SolveCubicE(coeffs.p, roots.p, &res))
+	return int(res), err
+}
+
 // SolvePoly finds the real or complex roots of a polynomial equation.
 //
 // For further details, please see:
@@ -1723,6 +2914,13 @@ func SolvePoly(coeffs Mat, roots *Mat, maxIters int) float64 {
 	return float64(C.Mat_SolvePoly(coeffs.p, roots.p, C.int(maxIters)))
 }
 
+// SolvePolyE is the same as SolvePoly, but returns an error if OpenCV raises an exception.
+func SolvePolyE(coeffs Mat, roots *Mat, maxIters int) (float64, error) {
+	var res C.double
+	err := toError(C.Mat_SolvePolyE(coeffs.p, roots.p, C.int(maxIters), &res))
+	return float64(res), err
+}
+
 type ReduceTypes int
 
 const (
@@ -1748,6 +2946,11 @@ func Reduce(src Mat, dst *Mat, dim int, rType ReduceTypes, dType MatType) {
 	C.Mat_Reduce(src.p, dst.p, C.int(dim), C.int(rType), C.int(dType))
 }
 
+// ReduceE is the same as Reduce, but returns an error if OpenCV raises an exception.
+func ReduceE(src Mat, dst *Mat, dim int, rType ReduceTypes, dType MatType) error {
+	return toError(C.Mat_ReduceE(src.p, dst.p, C.int(dim), C.int(rType), C.int(dType)))
+}
+
 // Repeat fills the output array with repeated copies of the input array.
 //
 // For further details, please see:
@@ -1757,6 +2960,11 @@ func Repeat(src Mat, nY int, nX int, dst *Mat) {
 	C.Mat_Repeat(src.p, C.int(nY), C.int(nX), dst.p)
 }
 
+// RepeatE is the same as Repeat, but returns an error if OpenCV raises an exception.
+func RepeatE(src Mat, nY int, nX int, dst *Mat) error {
+	return toError(C.Mat_RepeatE(src.p, C.int(nY), C.int(nX), dst.p))
+}
+
 // Calculates the sum of a scaled array and another array.
 //
 // For further details, please see:
@@ -1766,6 +2974,11 @@ func ScaleAdd(src1 Mat, alpha float64, src2 Mat, dst *Mat) {
 	C.Mat_ScaleAdd(src1.p, C.double(alpha), src2.p, dst.p)
 }
 
+// ScaleAddE is the same as ScaleAdd, but returns an error if OpenCV raises an exception.
+func ScaleAddE(src1 Mat, alpha float64, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_ScaleAddE(src1.p, C.double(alpha), src2.p, dst.p))
+}
+
 // SetIdentity initializes a scaled identity matrix.
 // For further details, please see:
 //  https://docs.opencv.org/master/d2/de8/group__core__array.html#ga388d7575224a4a277ceb98ccaa327c99
@@ -1774,6 +2987,11 @@ func SetIdentity(src Mat, scalar float64) {
 	C.Mat_SetIdentity(src.p, C.double(scalar))
 }
 
+// SetIdentityE is the same as SetIdentity, but returns an error if OpenCV raises an exception.
+func SetIdentityE(src Mat, scalar float64) error {
+	return toError(C.Mat_SetIdentityE(src.p, C.double(scalar)))
+}
+
 type SortFlags int
 
 const (
@@ -1799,6 +3017,11 @@ func Sort(src Mat, dst *Mat, flags SortFlags) {
 	C.Mat_Sort(src.p, dst.p, C.int(flags))
 }
 
+// SortE is the same as Sort, but returns an error if OpenCV raises an exception.
+func SortE(src Mat, dst *Mat, flags SortFlags) error {
+	return toError(C.Mat_SortE(src.p, dst.p, C.int(flags)))
+}
+
 // SortIdx sorts each row or each column of a matrix.
 // Instead of reordering the elements themselves, it stores the indices of sorted elements in the output array
 //
@@ -1809,6 +3032,11 @@ func SortIdx(src Mat, dst *Mat, flags SortFlags) {
 	C.Mat_SortIdx(src.p, dst.p, C.int(flags))
 }
 
+// SortIdxE is the same as SortIdx, but returns an error if OpenCV raises an exception.
+func SortIdxE(src Mat, dst *Mat, flags SortFlags) error {
+	return toError(C.Mat_SortIdxE(src.p, dst.p, C.int(flags)))
+}
+
 // Split creates an array of single channel images from a multi-channel image
 // Created images should be closed manualy to avoid memory leaks.
 //
@@ -1818,13 +3046,16 @@ func SortIdx(src Mat, dst *Mat, flags SortFlags) {
 func Split(src Mat) (mv []Mat) {
 	cMats := C.struct_Mats{}
 	C.Mat_Split(src.p, &(cMats))
-	defer C.Mats_Close(cMats)
-	mv = make([]Mat, cMats.length)
-	for i := C.int(0); i < cMats.length; i++ {
-		mv[i].p = C.Mats_get(cMats, i)
-		addMatToProfile(mv[i].p)
+	return toGoMats(cMats)
+}
+
+// SplitE is the same as Split, but returns an error if OpenCV raises an exception.
+func SplitE(src Mat) ([]Mat, error) {
+	cMats := C.struct_Mats{}
+	if err := toError(C.Mat_SplitE(src.p, &(cMats))); err != nil {
+		return nil, err
 	}
-	return
+	return toGoMats(cMats), nil
 }
 
 // Subtract calculates the per-element subtraction of two arrays or an array and a scalar.
@@ -1836,6 +3067,88 @@ func Subtract(src1 Mat, src2 Mat, dst *Mat) {
 	C.Mat_Subtract(src1.p, src2.p, dst.p)
 }
 
+// SubtractE is the same as Subtract, but returns an error if OpenCV raises an exception.
+func SubtractE(src1 Mat, src2 Mat, dst *Mat) error {
+	return toError(C.Mat_SubtractE(src1.p, src2.p, dst.p))
+}
+
+// SubtractWithParams calculates the per-element subtraction of two arrays, only
+// for the elements where mask is non-zero. mask may be an empty Mat, and a dtype
+// of -1 produces a dst with the same depth as the inputs.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gaa0f00d98b4b5edeaeb7b8333b2de353b
+//
+func SubtractWithParams(src1 Mat, src2 Mat, dst *Mat, mask Mat, dtype MatType) {
+	C.Mat_SubtractWithParams(src1.p, src2.p, dst.p, mask.p, C.int(dtype))
+}
+
+// SubtractWithParamsE is the same as SubtractWithParams, but returns an error if OpenCV raises an exception.
+func SubtractWithParamsE(src1 Mat, src2 Mat, dst *Mat, mask Mat, dtype MatType) error {
+	return toError(C.Mat_SubtractWithParamsE(src1.p, src2.p, dst.p, mask.p, C.int(dtype)))
+}
+
+// SubtractScalar calculates the per-element subtraction of a scalar from an array.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gaa0f00d98b4b5edeaeb7b8333b2de353b
+//
+func SubtractScalar(src Mat, s Scalar, dst *Mat) {
+	C.Mat_SubtractScalar(src.p, toCScalar(s), dst.p)
+}
+
+// SubtractScalarE is the same as SubtractScalar, but returns an error if OpenCV raises an exception.
+func SubtractScalarE(src Mat, s Scalar, dst *Mat) error {
+	return toError(C.Mat_SubtractScalarE(src.p, toCScalar(s), dst.p))
+}
+
+// SubtractScalarWithParams calculates the per-element subtraction of a scalar
+// from an array, only for the elements where mask is non-zero. mask may be an
+// empty Mat, and a dtype of -1 produces a dst with the same depth as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gaa0f00d98b4b5edeaeb7b8333b2de353b
+//
+func SubtractScalarWithParams(src Mat, s Scalar, dst *Mat, mask Mat, dtype MatType) {
+	C.Mat_SubtractScalarWithParams(src.p, toCScalar(s), dst.p, mask.p, C.int(dtype))
+}
+
+// SubtractScalarWithParamsE is the same as SubtractScalarWithParams, but returns an error if OpenCV raises an exception.
+func SubtractScalarWithParamsE(src Mat, s Scalar, dst *Mat, mask Mat, dtype MatType) error {
+	return toError(C.Mat_SubtractScalarWithParamsE(src.p, toCScalar(s), dst.p, mask.p, C.int(dtype)))
+}
+
+// SubtractFromScalar calculates the per-element subtraction of an array from
+// a scalar, as s - src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gaa0f00d98b4b5edeaeb7b8333b2de353b
+//
+func SubtractFromScalar(s Scalar, src Mat, dst *Mat) {
+	C.Mat_SubtractFromScalar(toCScalar(s), src.p, dst.p)
+}
+
+// SubtractFromScalarE is the same as SubtractFromScalar, but returns an error if OpenCV raises an exception.
+func SubtractFromScalarE(s Scalar, src Mat, dst *Mat) error {
+	return toError(C.Mat_SubtractFromScalarE(toCScalar(s), src.p, dst.p))
+}
+
+// SubtractFromScalarWithParams calculates the per-element subtraction of an
+// array from a scalar, only for the elements where mask is non-zero. mask may
+// be an empty Mat, and a dtype of -1 produces a dst with the same depth as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gaa0f00d98b4b5edeaeb7b8333b2de353b
+//
+func SubtractFromScalarWithParams(s Scalar, src Mat, dst *Mat, mask Mat, dtype MatType) {
+	C.Mat_SubtractFromScalarWithParams(toCScalar(s), src.p, dst.p, mask.p, C.int(dtype))
+}
+
+// SubtractFromScalarWithParamsE is the same as SubtractFromScalarWithParams, but returns an error if OpenCV raises an exception.
+func SubtractFromScalarWithParamsE(s Scalar, src Mat, dst *Mat, mask Mat, dtype MatType) error {
+	return toError(C.Mat_SubtractFromScalarWithParamsE(toCScalar(s), src.p, dst.p, mask.p, C.int(dtype)))
+}
+
 // Trace returns the trace of a matrix.
 //
 // For further details, please see:
@@ -1846,6 +3159,15 @@ func Trace(src Mat) Scalar {
 	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4))
 }
 
+// TraceE is the same as Trace, but returns an error if OpenCV raises an exception.
+func TraceE(src Mat) (Scalar, error) {
+	var s C.Scalar
+	if err := toError(C.Mat_TraceE(src.p, &s)); err != nil {
+		return Scalar{}, err
+	}
+	return NewScalar(float64(s.val1), float64(s.val2), float64(s.val3), float64(s.val4)), nil
+}
+
 // Transform performs the matrix transformation of every array element.
 //
 // For further details, please see:
@@ -1855,6 +3177,11 @@ func Transform(src Mat, dst *Mat, tm Mat) {
 	C.Mat_Transform(src.p, dst.p, tm.p)
 }
 
+// TransformE is the same as Transform, but returns an error if OpenCV raises an exception.
+func TransformE(src Mat, dst *Mat, tm Mat) error {
+	return toError(C.Mat_TransformE(src.p, dst.p, tm.p))
+}
+
 // Transpose transposes a matrix.
 //
 // For further details, please see:
@@ -1864,6 +3191,11 @@ func Transpose(src Mat, dst *Mat) {
 	C.Mat_Transpose(src.p, dst.p)
 }
 
+// TransposeE is the same as Transpose, but returns an error if OpenCV raises an exception.
+func TransposeE(src Mat, dst *Mat) error {
+	return toError(C.Mat_TransposeE(src.p, dst.p))
+}
+
 // Pow raises every array element to a power.
 //
 // For further details, please see:
@@ -1873,6 +3205,11 @@ func Pow(src Mat, power float64, dst *Mat) {
 	C.Mat_Pow(src.p, C.double(power), dst.p)
 }
 
+// PowE is the same as Pow, but returns an error if OpenCV raises an exception.
+func PowE(src Mat, power float64, dst *Mat) error {
+	return toError(C.Mat_PowE(src.p, C.double(power), dst.p))
+}
+
 // PolatToCart calculates x and y coordinates of 2D vectors from their magnitude and angle.
 //
 // For further details, please see:
@@ -1882,6 +3219,11 @@ func PolarToCart(magnitude Mat, degree Mat, x *Mat, y *Mat, angleInDegrees bool)
 	C.Mat_PolarToCart(magnitude.p, degree.p, x.p, y.p, C.bool(angleInDegrees))
 }
 
+// PolarToCartE is the same as PolarToCart, but returns an error if OpenCV raises an exception.
+func PolarToCartE(magnitude Mat, degree Mat, x *Mat, y *Mat, angleInDegrees bool) error {
+	return toError(C.Mat_PolarToCartE(magnitude.p, degree.p, x.p, y.p, C.bool(angleInDegrees)))
+}
+
 // Phase calculates the rotation angle of 2D vectors.
 //
 // For further details, please see:
@@ -1891,6 +3233,11 @@ func Phase(x, y Mat, angle *Mat, angleInDegrees bool) {
 	C.Mat_Phase(x.p, y.p, angle.p, C.bool(angleInDegrees))
 }
 
+// PhaseE is the same as Phase, but returns an error if OpenCV raises an exception.
+func PhaseE(x, y Mat, angle *Mat, angleInDegrees bool) error {
+	return toError(C.Mat_PhaseE(x.p, y.p, angle.p, C.bool(angleInDegrees)))
+}
+
 // TermCriteria is the criteria for iterative algorithms.
 //
 // For further details, please see:
@@ -1923,6 +3270,16 @@ func NewScalar(v1 float64, v2 float64, v3 float64, v4 float64) Scalar {
 	return s
 }
 
+// toCScalar converts a Scalar to its C representation.
+func toCScalar(s Scalar) C.struct_Scalar {
+	return C.struct_Scalar{
+		val1: C.double(s.Val1),
+		val2: C.double(s.Val2),
+		val3: C.double(s.Val3),
+		val4: C.double(s.Val4),
+	}
+}
+
 // KeyPoint is data structure for salient point detectors.
 //
 // For further details, please see:
@@ -2346,6 +3703,10 @@ func toCPoints(points []image.Point) C.struct_Points {
 }
 
 func toCPoints2f(points []Point2f) C.struct_Points2f {
+	if len(points) == 0 {
+		return C.struct_Points2f{}
+	}
+
 	cPointSlice := make([]C.struct_Point2f, len(points))
 	for i, point := range points {
 		cPointSlice[i] = C.struct_Point2f{
@@ -2360,6 +3721,97 @@ func toCPoints2f(points []Point2f) C.struct_Points2f {
 	}
 }
 
+func toCPoints3f(points []Point3f) C.struct_Points3f {
+	if len(points) == 0 {
+		return C.struct_Points3f{}
+	}
+
+	cPointSlice := make([]C.struct_Point3f, len(points))
+	for i, point := range points {
+		cPointSlice[i] = C.struct_Point3f{
+			x: C.float(point.X),
+			y: C.float(point.Y),
+			z: C.float(point.Z),
+		}
+	}
+
+	return C.struct_Points3f{
+		points: (*C.Point3f)(&cPointSlice[0]),
+		length: C.int(len(points)),
+	}
+}
+
+// toCRect converts an image.Rectangle to a C Rect.
+func toCRect(rect image.Rectangle) C.struct_Rect {
+	return C.struct_Rect{
+		x:      C.int(rect.Min.X),
+		y:      C.int(rect.Min.Y),
+		width:  C.int(rect.Dx()),
+		height: C.int(rect.Dy()),
+	}
+}
+
+// toCPoint2f converts a Point2f to a C Point2f.
+func toCPoint2f(pt Point2f) C.struct_Point2f {
+	return C.struct_Point2f{
+		x: C.float(pt.X),
+		y: C.float(pt.Y),
+	}
+}
+
+// fromCPoint2f converts a C Point2f to a Point2f.
+func fromCPoint2f(pt C.struct_Point2f) Point2f {
+	return Point2f{
+		X: float32(pt.x),
+		Y: float32(pt.y),
+	}
+}
+
+// toGoInts copies the values of an IntVector to a slice of int.
+func toGoInts(ivec C.struct_IntVector) []int {
+	if ivec.length == 0 {
+		return []int{}
+	}
+
+	cInts := unsafe.Slice((*C.int)(unsafe.Pointer(ivec.val)), int(ivec.length))
+	ints := make([]int, len(cInts))
+	for i, v := range cInts {
+		ints[i] = int(v)
+	}
+	return ints
+}
+
+// toCMats wraps a slice of Mat as a C Mats struct. The returned struct
+// references the Go backing array, so it must not outlive the call it is
+// passed to.
+func toCMats(mats []Mat) C.struct_Mats {
+	cMats := C.struct_Mats{
+		length: C.int(len(mats)),
+	}
+	if len(mats) == 0 {
+		return cMats
+	}
+
+	cMatArray := make([]C.Mat, len(mats))
+	for i, m := range mats {
+		cMatArray[i] = m.p
+	}
+	cMats.mats = (*C.Mat)(&cMatArray[0])
+	return cMats
+}
+
+// toGoMats converts a C Mats struct returned from OpenCV to a slice of Mat,
+// and releases the struct.
+func toGoMats(cMats C.struct_Mats) []Mat {
+	defer C.Mats_Close(cMats)
+
+	mats := make([]Mat, cMats.length)
+	for i := C.int(0); i < cMats.length; i++ {
+		mats[i] = newMat(C.Mats_get(cMats, i))
+	}
+	return mats
+}
+
 func toCStrings(strs []string) C.struct_CStrings {
 	cStringsSlice := make([]*C.char, len(strs))
 	for i, s := range strs {
@@ -2381,6 +3833,15 @@ func (m *Mat) RowRange(start, end int) Mat {
 	return newMat(C.Mat_rowRange(m.p, C.int(start), C.int(end)))
 }
 
+// RowRangeE is the same as RowRange, but returns an error if OpenCV raises an exception.
+func (m *Mat) RowRangeE(start, end int) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_rowRangeE(m.p, C.int(start), C.int(end), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // ColRange creates a matrix header for the specified column span.
 //
 // For further details, please see:
@@ -2390,6 +3851,15 @@ func (m *Mat) ColRange(start, end int) Mat {
 	return newMat(C.Mat_colRange(m.p, C.int(start), C.int(end)))
 }
 
+// ColRangeE is the same as ColRange, but returns an error if OpenCV raises an exception.
+func (m *Mat) ColRangeE(start, end int) (Mat, error) {
+	var res C.Mat
+	if err := toError(C.Mat_colRangeE(m.p, C.int(start), C.int(end), &res)); err != nil {
+		return Mat{}, err
+	}
+	return newMat(res), nil
+}
+
 // RNG Random Number Generator.
 // It encapsulates the state (currently, a 64-bit integer) and
 // has methods to return scalar random values and to fill arrays
@@ -2440,6 +3910,11 @@ func (r *RNG) Fill(mat *Mat, distType RNGDistType, a, b float64, saturateRange b
 	C.RNG_Fill(r.p, mat.p, C.int(distType), C.double(a), C.double(b), C.bool(saturateRange))
 }
 
+// FillE is the same as Fill, but returns an error if OpenCV raises an exception.
+func (r *RNG) FillE(mat *Mat, distType RNGDistType, a, b float64, saturateRange bool) error {
+	return toError(C.RNG_FillE(r.p, mat.p, C.int(distType), C.double(a), C.double(b), C.bool(saturateRange)))
+}
+
 // Gaussian Returns the next random number sampled from
 // the Gaussian distribution.
 //
@@ -2482,6 +3957,24 @@ func RandN(mat *Mat, mean, stddev Scalar) {
 	C.RandN(mat.p, meanVal, stddevVal)
 }
 
+// RandNE is the same as RandN, but returns an error if OpenCV raises an exception.
+func RandNE(mat *Mat, mean, stddev Scalar) error {
+	meanVal := C.struct_Scalar{
+		val1: C.double(mean.Val1),
+		val2: C.double(mean.Val2),
+		val3: C.double(mean.Val3),
+		val4: C.double(mean.Val4),
+	}
+	stddevVal := C.struct_Scalar{
+		val1: C.double(stddev.Val1),
+		val2: C.double(stddev.Val2),
+		val3: C.double(stddev.Val3),
+		val4: C.double(stddev.Val4),
+	}
+
+	return toError(C.RandNE(mat.p, meanVal, stddevVal))
+}
+
 // RandShuffle Shuffles the array elements randomly.
 //
 // For further details, please see:
@@ -2491,6 +3984,11 @@ func RandShuffle(mat *Mat) {
 	C.RandShuffle(mat.p)
 }
 
+// RandShuffleE is the same as RandShuffle, but returns an error if OpenCV raises an exception.
+func RandShuffleE(mat *Mat) error {
+	return toError(C.RandShuffleE(mat.p))
+}
+
 // RandShuffleWithParams Shuffles the array elements randomly.
 //
 // For further details, please see:
@@ -2500,6 +3998,11 @@ func RandShuffleWithParams(mat *Mat, iterFactor float64, rng RNG) {
 	C.RandShuffleWithParams(mat.p, C.double(iterFactor), rng.p)
 }
 
+// RandShuffleWithParamsE is the same as RandShuffleWithParams, but returns an error if OpenCV raises an exception.
+func RandShuffleWithParamsE(mat *Mat, iterFactor float64, rng RNG) error {
+	return toError(C.RandShuffleWithParamsE(mat.p, C.double(iterFactor), rng.p))
+}
+
 // RandU Generates a single uniformly-distributed random
 // number or an array of random numbers.
 //
@@ -2522,3 +4025,21 @@ func RandU(mat *Mat, low, high Scalar) {
 
 	C.RandU(mat.p, lowVal, highVal)
 }
+
+// RandUE is the same as RandU, but returns an error if OpenCV raises an exception.
+func RandUE(mat *Mat, low, high Scalar) error {
+	lowVal := C.struct_Scalar{
+		val1: C.double(low.Val1),
+		val2: C.double(low.Val2),
+		val3: C.double(low.Val3),
+		val4: C.double(low.Val4),
+	}
+	highVal := C.struct_Scalar{
+		val1: C.double(high.Val1),
+		val2: C.double(high.Val2),
+		val3: C.double(high.Val3),
+		val4: C.double(high.Val4),
+	}
+
+	return toError(C.RandUE(mat.p, lowVal, highVal))
+}
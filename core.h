@@ -27,6 +27,16 @@ typedef struct FloatVector {
     int length;
 } FloatVector;
 
+// Wrapper for a cv::Exception caught by the functions with an E suffix.
+// msg is NULL when no exception was raised.
+typedef struct OpenCVResult {
+    int code;
+    const char* func;
+    const char* file;
+    int line;
+    const char* msg;
+} OpenCVResult;
+
 #ifdef __cplusplus
 #include <opencv2/opencv.hpp>
 extern "C" {
@@ -44,6 +54,13 @@ typedef struct Point2f {
     float y;
 } Point2f;
 
+// Wrapper for an individual cv::Point3f
+typedef struct Point3f {
+    float x;
+    float y;
+    float z;
+} Point3f;
+
 // Wrapper for an individual cv::cvPoint
 typedef struct Point {
     int x;
@@ -62,6 +79,12 @@ typedef struct Points2f {
     int length;
 } Points2f;
 
+// Wrapper for the vector of Point3f structs aka std::vector<Point3f>
+typedef struct Points3f {
+    Point3f* points;
+    int length;
+} Points3f;
+
 // Contour is alias for Points
 typedef Points Contour;
 
@@ -94,6 +117,12 @@ typedef struct Size {
     int height;
 } Size;
 
+// Wrapper for an individual cv::Size2f
+typedef struct Size2f {
+    float width;
+    float height;
+} Size2f;
+
 // Wrapper for an individual cv::RotatedRect
 typedef struct RotatedRect {
     Points pts;
@@ -103,6 +132,15 @@ typedef struct RotatedRect {
     double angle;
 } RotatedRect;
 
+// Wrapper for an individual cv::RotatedRect with subpixel coordinates
+typedef struct RotatedRect2f {
+    Points2f pts;
+    Rect boundingRect;
+    Point2f center;
+    Size2f size;
+    double angle;
+} RotatedRect2f;
+
 // Wrapper for an individual cv::cvScalar
 typedef struct Scalar {
     double val1;
@@ -235,45 +273,68 @@ void Rects_Close(struct Rects rs);
 void Mats_Close(struct Mats mats);
 void Point_Close(struct Point p);
 void Points_Close(struct Points ps);
+void Points2f_Close(struct Points2f ps);
 void DMatches_Close(struct DMatches ds);
 void MultiDMatches_Close(struct MultiDMatches mds);
 
 Mat Mat_New();
 Mat Mat_NewWithSize(int rows, int cols, int type);
+OpenCVResult Mat_NewWithSizeE(int rows, int cols, int type, Mat* res);
 Mat Mat_NewWithSizes(struct IntVector sizes, int type);
+OpenCVResult Mat_NewWithSizesE(struct IntVector sizes, int type, Mat* res);
 Mat Mat_NewWithSizesFromScalar(IntVector sizes, int type, Scalar ar);
+OpenCVResult Mat_NewWithSizesFromScalarE(IntVector sizes, int type, Scalar ar, Mat* res);
 Mat Mat_NewWithSizesFromBytes(IntVector sizes, int type, struct ByteArray buf);
 Mat Mat_NewFromScalar(const Scalar ar, int type);
+OpenCVResult Mat_NewFromScalarE(Scalar ar, int type, Mat* res);
 Mat Mat_NewWithSizeFromScalar(const Scalar ar, int rows, int cols, int type);
+OpenCVResult Mat_NewWithSizeFromScalarE(Scalar ar, int rows, int cols, int type, Mat* res);
 Mat Mat_NewFromBytes(int rows, int cols, int type, struct ByteArray buf);
+Mat Mat_NewFromBytesWithStep(int rows, int cols, int type, struct ByteArray buf, int step);
 Mat Mat_FromPtr(Mat m, int rows, int cols, int type, int prows, int pcols);
 void Mat_Close(Mat m);
 int Mat_Empty(Mat m);
 bool Mat_IsContinuous(Mat m);
 Mat Mat_Clone(Mat m);
+OpenCVResult Mat_CloneE(Mat m, Mat* res);
 void Mat_CopyTo(Mat m, Mat dst);
+OpenCVResult Mat_CopyToE(Mat m, Mat dst);
 int Mat_Total(Mat m);
 void Mat_Size(Mat m, IntVector* res);
 void Mat_CopyToWithMask(Mat m, Mat dst, Mat mask);
+OpenCVResult Mat_CopyToWithMaskE(Mat m, Mat dst, Mat mask);
 void Mat_ConvertTo(Mat m, Mat dst, int type);
+OpenCVResult Mat_ConvertToE(Mat m, Mat dst, int type);
 void Mat_ConvertToWithParams(Mat m, Mat dst, int type, float alpha, float beta);
+OpenCVResult Mat_ConvertToWithParamsE(Mat m, Mat dst, int type, float alpha, float beta);
 struct ByteArray Mat_ToBytes(Mat m);
 struct ByteArray Mat_DataPtr(Mat m);
 Mat Mat_Region(Mat m, Rect r);
+OpenCVResult Mat_RegionE(Mat m, Rect r, Mat* res);
 Mat Mat_Reshape(Mat m, int cn, int rows);
+OpenCVResult Mat_ReshapeE(Mat m, int cn, int rows, Mat* res);
 void Mat_PatchNaNs(Mat m);
+OpenCVResult Mat_PatchNaNsE(Mat m);
 Mat Mat_ConvertFp16(Mat m);
+OpenCVResult Mat_ConvertFp16E(Mat m, Mat* res);
 Scalar Mat_Mean(Mat m);
+OpenCVResult Mat_MeanE(Mat m, Scalar* res);
 Scalar Mat_MeanWithMask(Mat m, Mat mask);
+OpenCVResult Mat_MeanWithMaskE(Mat m, Mat mask, Scalar* res);
 Mat Mat_Sqrt(Mat m);
+OpenCVResult Mat_SqrtE(Mat m, Mat* res);
 int Mat_Rows(Mat m);
 int Mat_Cols(Mat m);
 int Mat_Channels(Mat m);
 int Mat_Type(Mat m);
 int Mat_Step(Mat m);
+void Mat_Steps(Mat m, IntVector* res);
 Mat Eye(int rows, int cols, int type);
+OpenCVResult EyeE(int rows, int cols, int type, Mat* res);
 Mat Zeros(int rows, int cols, int type);
+OpenCVResult ZerosE(int rows, int cols, int type, Mat* res);
 Mat Ones(int rows, int cols, int type);
+OpenCVResult OnesE(int rows, int cols, int type, Mat* res);
 
 uint8_t Mat_GetUChar(Mat m, int row, int col);
 uint8_t Mat_GetUChar3(Mat m, int x, int y, int z);
@@ -289,6 +350,7 @@ double Mat_GetDouble(Mat m, int row, int col);
 double Mat_GetDouble3(Mat m, int x, int y, int z);
 
 void Mat_SetTo(Mat m, Scalar value);
+OpenCVResult Mat_SetToE(Mat m, Scalar value);
 void Mat_SetUChar(Mat m, int row, int col, uint8_t val);
 void Mat_SetUChar3(Mat m, int x, int y, int z, uint8_t val);
 void Mat_SetSChar(Mat m, int row, int col, int8_t val);
@@ -303,100 +365,239 @@ void Mat_SetDouble(Mat m, int row, int col, double val);
 void Mat_SetDouble3(Mat m, int x, int y, int z, double val);
 
 void Mat_AddUChar(Mat m, uint8_t val);
+OpenCVResult Mat_AddUCharE(Mat m, uint8_t val);
 void Mat_SubtractUChar(Mat m, uint8_t val);
+OpenCVResult Mat_SubtractUCharE(Mat m, uint8_t val);
 void Mat_MultiplyUChar(Mat m, uint8_t val);
+OpenCVResult Mat_MultiplyUCharE(Mat m, uint8_t val);
 void Mat_DivideUChar(Mat m, uint8_t val);
+OpenCVResult Mat_DivideUCharE(Mat m, uint8_t val);
 void Mat_AddFloat(Mat m, float val);
+OpenCVResult Mat_AddFloatE(Mat m, float val);
 void Mat_SubtractFloat(Mat m, float val);
+OpenCVResult Mat_SubtractFloatE(Mat m, float val);
 void Mat_MultiplyFloat(Mat m, float val);
+OpenCVResult Mat_MultiplyFloatE(Mat m, float val);
 void Mat_DivideFloat(Mat m, float val);
+OpenCVResult Mat_DivideFloatE(Mat m, float val);
 Mat Mat_MultiplyMatrix(Mat x, Mat y);
+OpenCVResult Mat_MultiplyMatrixE(Mat x, Mat y, Mat* res);
 
 Mat Mat_T(Mat x);
+OpenCVResult Mat_TE(Mat x, Mat* res);
 
 void LUT(Mat src, Mat lut, Mat dst);
+OpenCVResult LUTE(Mat src, Mat lut, Mat dst);
 
 void Mat_AbsDiff(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_AbsDiffE(Mat src1, Mat src2, Mat dst);
+void Mat_AbsDiffScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_AbsDiffScalarE(Mat src, Scalar value, Mat dst);
+void Mat_Abs(Mat src, Mat dst);
+OpenCVResult Mat_AbsE(Mat src, Mat dst);
 void Mat_Add(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_AddE(Mat src1, Mat src2, Mat dst);
+void Mat_AddWithParams(Mat src1, Mat src2, Mat dst, Mat mask, int dtype);
+OpenCVResult Mat_AddWithParamsE(Mat src1, Mat src2, Mat dst, Mat mask, int dtype);
+void Mat_AddScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_AddScalarE(Mat src, Scalar value, Mat dst);
+void Mat_AddScalarWithParams(Mat src, Scalar value, Mat dst, Mat mask, int dtype);
+OpenCVResult Mat_AddScalarWithParamsE(Mat src, Scalar value, Mat dst, Mat mask, int dtype);
 void Mat_AddWeighted(Mat src1, double alpha, Mat src2, double beta, double gamma, Mat dst);
+OpenCVResult Mat_AddWeightedE(Mat src1, double alpha, Mat src2, double beta, double gamma, Mat dst);
 void Mat_BitwiseAnd(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_BitwiseAndE(Mat src1, Mat src2, Mat dst);
 void Mat_BitwiseAndWithMask(Mat src1, Mat src2, Mat dst, Mat mask);
+OpenCVResult Mat_BitwiseAndWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask);
 void Mat_BitwiseNot(Mat src1, Mat dst);
+OpenCVResult Mat_BitwiseNotE(Mat src1, Mat dst);
 void Mat_BitwiseNotWithMask(Mat src1, Mat dst, Mat mask);
+OpenCVResult Mat_BitwiseNotWithMaskE(Mat src1, Mat dst, Mat mask);
 void Mat_BitwiseOr(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_BitwiseOrE(Mat src1, Mat src2, Mat dst);
 void Mat_BitwiseOrWithMask(Mat src1, Mat src2, Mat dst, Mat mask);
+OpenCVResult Mat_BitwiseOrWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask);
 void Mat_BitwiseXor(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_BitwiseXorE(Mat src1, Mat src2, Mat dst);
 void Mat_BitwiseXorWithMask(Mat src1, Mat src2, Mat dst, Mat mask);
+OpenCVResult Mat_BitwiseXorWithMaskE(Mat src1, Mat src2, Mat dst, Mat mask);
 void Mat_Compare(Mat src1, Mat src2, Mat dst, int ct);
+OpenCVResult Mat_CompareE(Mat src1, Mat src2, Mat dst, int ct);
+void Mat_CompareScalar(Mat src, double value, Mat dst, int ct);
+OpenCVResult Mat_CompareScalarE(Mat src, double value, Mat dst, int ct);
 void Mat_BatchDistance(Mat src1, Mat src2, Mat dist, int dtype, Mat nidx, int normType, int K,
                        Mat mask, int update, bool crosscheck);
+OpenCVResult Mat_BatchDistanceE(Mat src1, Mat src2, Mat dist, int dtype, Mat nidx, int normType, int K,
+                                Mat mask, int update, bool crosscheck);
 int Mat_BorderInterpolate(int p, int len, int borderType);
+OpenCVResult Mat_BorderInterpolateE(int p, int len, int borderType, int* res);
 void Mat_CalcCovarMatrix(Mat samples, Mat covar, Mat mean, int flags, int ctype);
+OpenCVResult Mat_CalcCovarMatrixE(Mat samples, Mat covar, Mat mean, int flags, int ctype);
 void Mat_CartToPolar(Mat x, Mat y, Mat magnitude, Mat angle, bool angleInDegrees);
+OpenCVResult Mat_CartToPolarE(Mat x, Mat y, Mat magnitude, Mat angle, bool angleInDegrees);
 bool Mat_CheckRange(Mat m);
+OpenCVResult Mat_CheckRangeE(Mat m, bool* res);
 void Mat_CompleteSymm(Mat m, bool lowerToUpper);
+OpenCVResult Mat_CompleteSymmE(Mat m, bool lowerToUpper);
 void Mat_ConvertScaleAbs(Mat src, Mat dst, double alpha, double beta);
+OpenCVResult Mat_ConvertScaleAbsE(Mat src, Mat dst, double alpha, double beta);
 void Mat_CopyMakeBorder(Mat src, Mat dst, int top, int bottom, int left, int right, int borderType,
                         Scalar value);
+OpenCVResult Mat_CopyMakeBorderE(Mat src, Mat dst, int top, int bottom, int left, int right, int borderType,
+                                 Scalar value);
 int Mat_CountNonZero(Mat src);
+OpenCVResult Mat_CountNonZeroE(Mat src, int* res);
 void Mat_DCT(Mat src, Mat dst, int flags);
+OpenCVResult Mat_DCTE(Mat src, Mat dst, int flags);
 double Mat_Determinant(Mat m);
+OpenCVResult Mat_DeterminantE(Mat m, double* res);
 void Mat_DFT(Mat m, Mat dst, int flags);
+OpenCVResult Mat_DFTE(Mat m, Mat dst, int flags);
 void Mat_Divide(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_DivideE(Mat src1, Mat src2, Mat dst);
+void Mat_DivideWithParams(Mat src1, Mat src2, Mat dst, double scale, int dtype);
+OpenCVResult Mat_DivideWithParamsE(Mat src1, Mat src2, Mat dst, double scale, int dtype);
+void Mat_DivideScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_DivideScalarE(Mat src, Scalar value, Mat dst);
+void Mat_DivideScalarWithParams(Mat src, Scalar value, Mat dst, double scale, int dtype);
+OpenCVResult Mat_DivideScalarWithParamsE(Mat src, Scalar value, Mat dst, double scale, int dtype);
+void Mat_DivideFromScalar(double scale, Mat src, Mat dst, int dtype);
+OpenCVResult Mat_DivideFromScalarE(double scale, Mat src, Mat dst, int dtype);
 bool Mat_Eigen(Mat src, Mat eigenvalues, Mat eigenvectors);
+OpenCVResult Mat_EigenE(Mat src, Mat eigenvalues, Mat eigenvectors, bool* res);
 void Mat_EigenNonSymmetric(Mat src, Mat eigenvalues, Mat eigenvectors);
+OpenCVResult Mat_EigenNonSymmetricE(Mat src, Mat eigenvalues, Mat eigenvectors);
 void Mat_Exp(Mat src, Mat dst);
+OpenCVResult Mat_ExpE(Mat src, Mat dst);
 void Mat_ExtractChannel(Mat src, Mat dst, int coi);
+OpenCVResult Mat_ExtractChannelE(Mat src, Mat dst, int coi);
 void Mat_FindNonZero(Mat src, Mat idx);
+OpenCVResult Mat_FindNonZeroE(Mat src, Mat idx);
 void Mat_Flip(Mat src, Mat dst, int flipCode);
+OpenCVResult Mat_FlipE(Mat src, Mat dst, int flipCode);
 void Mat_Gemm(Mat src1, Mat src2, double alpha, Mat src3, double beta, Mat dst, int flags);
+OpenCVResult Mat_GemmE(Mat src1, Mat src2, double alpha, Mat src3, double beta, Mat dst, int flags);
 int Mat_GetOptimalDFTSize(int vecsize);
 void Mat_Hconcat(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_HconcatE(Mat src1, Mat src2, Mat dst);
 void Mat_Vconcat(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_VconcatE(Mat src1, Mat src2, Mat dst);
 void Rotate(Mat src, Mat dst, int rotationCode);
+OpenCVResult RotateE(Mat src, Mat dst, int rotateCode);
 void Mat_Idct(Mat src, Mat dst, int flags);
+OpenCVResult Mat_IdctE(Mat src, Mat dst, int flags);
 void Mat_Idft(Mat src, Mat dst, int flags, int nonzeroRows);
+OpenCVResult Mat_IdftE(Mat src, Mat dst, int flags, int nonzeroRows);
 void Mat_InRange(Mat src, Mat lowerb, Mat upperb, Mat dst);
+OpenCVResult Mat_InRangeE(Mat src, Mat lowerb, Mat upperb, Mat dst);
 void Mat_InRangeWithScalar(Mat src, const Scalar lowerb, const Scalar upperb, Mat dst);
+OpenCVResult Mat_InRangeWithScalarE(Mat src, Scalar lowerb, Scalar upperb, Mat dst);
 void Mat_InsertChannel(Mat src, Mat dst, int coi);
+OpenCVResult Mat_InsertChannelE(Mat src, Mat dst, int coi);
 double Mat_Invert(Mat src, Mat dst, int flags);
+OpenCVResult Mat_InvertE(Mat src, Mat dst, int flags, double* res);
 double KMeans(Mat data, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers);
+OpenCVResult KMeansE(Mat data, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers, double* res);
 double KMeansPoints(PointVector pts, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers);
+OpenCVResult KMeansPointsE(PointVector points, int k, Mat bestLabels, TermCriteria criteria, int attempts, int flags, Mat centers, double* res);
+int Partition(int n, int predicate, IntVector* labels);
 void Mat_Log(Mat src, Mat dst);
+OpenCVResult Mat_LogE(Mat src, Mat dst);
 void Mat_Magnitude(Mat x, Mat y, Mat magnitude);
+OpenCVResult Mat_MagnitudeE(Mat x, Mat y, Mat magnitude);
+double Mat_Mahalanobis(Mat v1, Mat v2, Mat icovar);
+OpenCVResult Mat_MahalanobisE(Mat v1, Mat v2, Mat icovar, double* res);
 void Mat_Max(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_MaxE(Mat src1, Mat src2, Mat dst);
+void Mat_MaxScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_MaxScalarE(Mat src, Scalar value, Mat dst);
 void Mat_MeanStdDev(Mat src, Mat dstMean, Mat dstStdDev);
+OpenCVResult Mat_MeanStdDevE(Mat src, Mat dstMean, Mat dstStdDev);
 void Mat_Merge(struct Mats mats, Mat dst);
+OpenCVResult Mat_MergeE(struct Mats mats, Mat dst);
 void Mat_Min(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_MinE(Mat src1, Mat src2, Mat dst);
+void Mat_MinScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_MinScalarE(Mat src, Scalar value, Mat dst);
 void Mat_MinMaxIdx(Mat m, double* minVal, double* maxVal, int* minIdx, int* maxIdx);
+OpenCVResult Mat_MinMaxIdxE(Mat m, double* minVal, double* maxVal, int* minIdx, int* maxIdx);
 void Mat_MinMaxLoc(Mat m, double* minVal, double* maxVal, Point* minLoc, Point* maxLoc);
+OpenCVResult Mat_MinMaxLocE(Mat m, double* minVal, double* maxVal, Point* minLoc, Point* maxLoc);
 void Mat_MixChannels(struct Mats src, struct Mats dst, struct IntVector fromTo);
+OpenCVResult Mat_MixChannelsE(struct Mats src, struct Mats dst, struct IntVector fromTo);
 void Mat_MulSpectrums(Mat a, Mat b, Mat c, int flags);
+OpenCVResult Mat_MulSpectrumsE(Mat a, Mat b, Mat c, int flags);
+void Mat_MulTransposed(Mat src, Mat dst, bool ata);
+OpenCVResult Mat_MulTransposedE(Mat src, Mat dst, bool ata);
+void Mat_MulTransposedWithParams(Mat src, Mat dst, bool ata, Mat delta, double scale, int dtype);
+OpenCVResult Mat_MulTransposedWithParamsE(Mat src, Mat dst, bool ata, Mat delta, double scale, int dtype);
 void Mat_Multiply(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_MultiplyE(Mat src1, Mat src2, Mat dst);
 void Mat_MultiplyWithParams(Mat src1, Mat src2, Mat dst, double scale, int dtype);
+OpenCVResult Mat_MultiplyWithParamsE(Mat src1, Mat src2, Mat dst, double scale, int dtype);
+void Mat_MultiplyScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_MultiplyScalarE(Mat src, Scalar value, Mat dst);
+void Mat_MultiplyScalarWithParams(Mat src, Scalar value, Mat dst, double scale, int dtype);
+OpenCVResult Mat_MultiplyScalarWithParamsE(Mat src, Scalar value, Mat dst, double scale, int dtype);
 void Mat_Subtract(Mat src1, Mat src2, Mat dst);
+OpenCVResult Mat_SubtractE(Mat src1, Mat src2, Mat dst);
+void Mat_SubtractWithParams(Mat src1, Mat src2, Mat dst, Mat mask, int dtype);
+OpenCVResult Mat_SubtractWithParamsE(Mat src1, Mat src2, Mat dst, Mat mask, int dtype);
+void Mat_SubtractScalar(Mat src, Scalar value, Mat dst);
+OpenCVResult Mat_SubtractScalarE(Mat src, Scalar value, Mat dst);
+void Mat_SubtractScalarWithParams(Mat src, Scalar value, Mat dst, Mat mask, int dtype);
+OpenCVResult Mat_SubtractScalarWithParamsE(Mat src, Scalar value, Mat dst, Mat mask, int dtype);
+void Mat_SubtractFromScalar(Scalar value, Mat src, Mat dst);
+OpenCVResult Mat_SubtractFromScalarE(Scalar value, Mat src, Mat dst);
+void Mat_SubtractFromScalarWithParams(Scalar value, Mat src, Mat dst, Mat mask, int dtype);
+OpenCVResult Mat_SubtractFromScalarWithParamsE(Scalar value, Mat src, Mat dst, Mat mask, int dtype);
 void Mat_Normalize(Mat src, Mat dst, double alpha, double beta, int typ);
+OpenCVResult Mat_NormalizeE(Mat src, Mat dst, double alpha, double beta, int typ);
 double Norm(Mat src1, int normType);
+OpenCVResult NormE(Mat src1, int normType, double* res);
 double NormWithMats(Mat src1, Mat src2, int normType);
+OpenCVResult NormWithMatsE(Mat src1, Mat src2, int normType, double* res);
 void Mat_PerspectiveTransform(Mat src, Mat dst, Mat tm);
+OpenCVResult Mat_PerspectiveTransformE(Mat src, Mat dst, Mat tm);
+double Mat_PSNR(Mat src1, Mat src2);
+OpenCVResult Mat_PSNRE(Mat src1, Mat src2, double* res);
+double Mat_PSNRWithParams(Mat src1, Mat src2, double r);
+OpenCVResult Mat_PSNRWithParamsE(Mat src1, Mat src2, double r, double* res);
 bool Mat_Solve(Mat src1, Mat src2, Mat dst, int flags);
+OpenCVResult Mat_SolveE(Mat src1, Mat src2, Mat dst, int flags, bool* res);
 int Mat_SolveCubic(Mat coeffs, Mat roots);
+OpenCVResult Mat_SolveCubicE(Mat coeffs, Mat roots, int* res);
 double Mat_SolvePoly(Mat coeffs, Mat roots, int maxIters);
+OpenCVResult Mat_SolvePolyE(Mat coeffs, Mat roots, int maxIters, double* res);
 void Mat_Reduce(Mat src, Mat dst, int dim, int rType, int dType);
+OpenCVResult Mat_ReduceE(Mat src, Mat dst, int dim, int rType, int dType);
 void Mat_Repeat(Mat src, int nY, int nX, Mat dst);
+OpenCVResult Mat_RepeatE(Mat src, int nY, int nX, Mat dst);
 void Mat_ScaleAdd(Mat src1, double alpha, Mat src2, Mat dst);
+OpenCVResult Mat_ScaleAddE(Mat src1, double alpha, Mat src2, Mat dst);
 void Mat_SetIdentity(Mat src, double scalar);
+OpenCVResult Mat_SetIdentityE(Mat src, double scalar);
 void Mat_Sort(Mat src, Mat dst, int flags);
+OpenCVResult Mat_SortE(Mat src, Mat dst, int flags);
 void Mat_SortIdx(Mat src, Mat dst, int flags);
+OpenCVResult Mat_SortIdxE(Mat src, Mat dst, int flags);
 void Mat_Split(Mat src, struct Mats* mats);
+OpenCVResult Mat_SplitE(Mat src, struct Mats* mats);
 void Mat_Subtract(Mat src1, Mat src2, Mat dst);
 Scalar Mat_Trace(Mat src);
+OpenCVResult Mat_TraceE(Mat src, Scalar* res);
 void Mat_Transform(Mat src, Mat dst, Mat tm);
+OpenCVResult Mat_TransformE(Mat src, Mat dst, Mat tm);
 void Mat_Transpose(Mat src, Mat dst);
+OpenCVResult Mat_TransposeE(Mat src, Mat dst);
 void Mat_PolarToCart(Mat magnitude, Mat degree, Mat x, Mat y, bool angleInDegrees);
+OpenCVResult Mat_PolarToCartE(Mat magnitude, Mat degree, Mat x, Mat y, bool angleInDegrees);
 void Mat_Pow(Mat src, double power, Mat dst);
+OpenCVResult Mat_PowE(Mat src, double power, Mat dst);
 void Mat_Phase(Mat x, Mat y, Mat angle, bool angleInDegrees);
+OpenCVResult Mat_PhaseE(Mat x, Mat y, Mat angle, bool angleInDegrees);
 Scalar Mat_Sum(Mat src1);
+OpenCVResult Mat_SumE(Mat src, Scalar* res);
 
 TermCriteria TermCriteria_New(int typ, int maxCount, double epsilon);
 
@@ -404,7 +605,9 @@ int64_t GetCVTickCount();
 double GetTickFrequency();
 
 Mat Mat_rowRange(Mat m,int startrow,int endrow);
+OpenCVResult Mat_rowRangeE(Mat m, int startrow, int endrow, Mat* res);
 Mat Mat_colRange(Mat m,int startrow,int endrow);
+OpenCVResult Mat_colRangeE(Mat m, int startcol, int endcol, Mat* res);
 
 PointVector PointVector_New();
 PointVector PointVector_NewFromPoints(Contour points);
@@ -435,21 +638,29 @@ RNG TheRNG();
 void SetRNGSeed(int seed);
 
 void RNG_Fill(RNG rng, Mat mat, int distType, double a, double b, bool saturateRange);
+OpenCVResult RNG_FillE(RNG rng, Mat mat, int distType, double a, double b, bool saturateRange);
 
 double RNG_Gaussian(RNG rng, double sigma);
 
 unsigned int RNG_Next(RNG rng);
 
 void RandN(Mat mat, Scalar mean, Scalar stddev);
+OpenCVResult RandNE(Mat mat, Scalar mean, Scalar stddev);
 
 void RandShuffle(Mat mat);
+OpenCVResult RandShuffleE(Mat mat);
 
 void RandShuffleWithParams(Mat mat, double iterFactor, RNG rng);
+OpenCVResult RandShuffleWithParamsE(Mat mat, double iterFactor, RNG rng);
 
 void RandU(Mat mat, Scalar low, Scalar high);
+OpenCVResult RandUE(Mat mat, Scalar low, Scalar high);
 
 void copyPointVectorToPoint2fVector(PointVector src, Point2fVector dest);
 
+// Implemented in Go, see core.go
+extern bool goPartitionPredicate(int predicate, int a, int b);
+
 #ifdef __cplusplus
 }
 #endif
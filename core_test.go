@@ -2,10 +2,12 @@ package gocv
 
 import (
 	"bytes"
+	"errors"
 	"image"
 	"image/color"
 	_ "image/jpeg"
 	_ "image/png"
+	"math"
 	"runtime"
 	"strings"
 	"testing"
@@ -215,6 +217,31 @@ func TestMatFromBytesSliceGarbageCollected(t *testing.T) {
 	}
 }
 
+func TestMatFromBytesWithStep(t *testing.T) {
+	data := []byte{0, 1, 2, 0, 3, 4, 5, 0}
+	m, err := NewMatFromBytesWithStep(2, 3, MatTypeCV8U, data, 4)
+	if err != nil {
+		t.Fatalf("TestMatFromBytesWithStep: %v", err)
+	}
+	defer m.Close()
+
+	if v := m.GetUCharAt(1, 2); v != 5 {
+		t.Errorf("TestMatFromBytesWithStep: unexpected value. Want %d, got %d.", 5, v)
+	}
+
+	data[4] = 9
+	if v := m.GetUCharAt(1, 0); v != 9 {
+		t.Errorf("TestMatFromBytesWithStep: Mat should reference data. Want %d, got %d.", 9, v)
+	}
+
+	if _, err := NewMatFromBytesWithStep(2, 3, MatTypeCV8UC2, data, 4); err != ErrInvalidStep {
+		t.Errorf("TestMatFromBytesWithStep: step smaller than a row must fail, got %v", err)
+	}
+	if _, err := NewMatFromBytesWithStep(3, 3, MatTypeCV8U, data, 4); err != ErrInvalidStep {
+		t.Errorf("TestMatFromBytesWithStep: data smaller than the Mat must fail, got %v", err)
+	}
+}
+
 func TestMatWithSize(t *testing.T) {
 	mat := NewMatWithSize(101, 102, MatTypeCV8U)
 	defer mat.Close()
@@ -768,6 +795,29 @@ func TestMatRegion(t *testing.T) {
 	}
 }
 
+func TestMatRegionE(t *testing.T) {
+	mat := NewMatWithSize(100, 100, MatTypeCV8U)
+	defer mat.Close()
+
+	region, err := mat.RegionE(image.Rect(20, 25, 80, 75))
+	if err != nil {
+		t.Fatalf("RegionE should not fail: %v", err)
+	}
+	defer region.Close()
+	if region.Rows() != 50 || region.Cols() != 60 {
+		t.Errorf("invalid region size: %vx%v", region.Rows(), region.Cols())
+	}
+
+	_, err = mat.RegionE(image.Rect(50, 50, 150, 150))
+	var cvErr *OpenCVError
+	if !errors.As(err, &cvErr) {
+		t.Fatalf("RegionE out of bounds should return an OpenCVError, got %v", err)
+	}
+	if cvErr.Code != StsAssert || cvErr.Func == "" || cvErr.File == "" || cvErr.Line == 0 {
+		t.Errorf("invalid OpenCVError: %#v", cvErr)
+	}
+}
+
 func TestMatReshape(t *testing.T) {
 	mat := NewMatWithSize(100, 100, MatTypeCV8UC4)
 	defer mat.Close()
@@ -1148,6 +1198,170 @@ func TestMatAdd(t *testing.T) {
 	}
 }
 
+func TestMatAddE(t *testing.T) {
+	mat1 := NewMatWithSize(10, 10, MatTypeCV8U)
+	defer mat1.Close()
+	mat2 := NewMatWithSize(10, 20, MatTypeCV8U)
+	defer mat2.Close()
+	dst := NewMat()
+	defer dst.Close()
+
+	err := AddE(mat1, mat2, &dst)
+	var cvErr *OpenCVError
+	if !errors.As(err, &cvErr) {
+		t.Fatalf("AddE with mismatched sizes should return an OpenCVError, got %v", err)
+	}
+	if cvErr.Code != StsUnmatchedSizes || cvErr.Msg == "" {
+		t.Errorf("invalid OpenCVError: %#v", cvErr)
+	}
+	if !strings.Contains(err.Error(), cvErr.Func) {
+		t.Errorf("invalid error message: %v", err)
+	}
+
+	if err := AddE(mat1, mat1, &dst); err != nil {
+		t.Errorf("AddE should not fail: %v", err)
+	}
+	if dst.Empty() {
+		t.Error("AddE dst should not be empty")
+	}
+}
+
+func TestMatAddWithParams(t *testing.T) {
+	mat1 := NewMatWithSizeFromScalar(NewScalar(200, 0, 0, 0), 2, 2, MatTypeCV8U)
+	defer mat1.Close()
+	mat2 := NewMatWithSizeFromScalar(NewScalar(100, 0, 0, 0), 2, 2, MatTypeCV8U)
+	defer mat2.Close()
+	mask := Zeros(2, 2, MatTypeCV8U)
+	defer mask.Close()
+	mask.SetUCharAt(0, 0, 1)
+
+	dst := Zeros(2, 2, MatTypeCV16U)
+	defer dst.Close()
+	AddWithParams(mat1, mat2, &dst, mask, MatTypeCV16U)
+	if dst.Type() != MatTypeCV16U {
+		t.Fatalf("invalid dst type: %v", dst.Type())
+	}
+	if v, _ := At[uint16](dst, 0, 0); v != 300 {
+		t.Errorf("invalid masked sum: %v", v)
+	}
+	if v, _ := At[uint16](dst, 1, 1); v != 0 {
+		t.Errorf("element outside mask should be unchanged: %v", v)
+	}
+
+	noMask := NewMat()
+	defer noMask.Close()
+	sat := NewMat()
+	defer sat.Close()
+	AddWithParams(mat1, mat2, &sat, noMask, -1)
+	if sat.GetUCharAt(1, 1) != 255 {
+		t.Errorf("sum should saturate: %v", sat.GetUCharAt(1, 1))
+	}
+}
+
+func TestMatScalarArithmetic(t *testing.T) {
+	src := NewMatWithSizeFromScalar(NewScalar(10, -20, 30, 0), 2, 3, MatTypeCV16SC3)
+	defer src.Close()
+	dst := NewMat()
+	defer dst.Close()
+
+	AddScalar(src, NewScalar(1, 2, 3, 0), &dst)
+	if v, _ := At[Vec3s](dst, 1, 2); v != (Vec3s{11, -18, 33}) {
+		t.Errorf("invalid AddScalar: %v", v)
+	}
+
+	SubtractScalar(src, NewScalar(10, 10, 10, 0), &dst)
+	if v, _ := At[Vec3s](dst, 0, 0); v != (Vec3s{0, -30, 20}) {
+		t.Errorf("invalid SubtractScalar: %v", v)
+	}
+
+	SubtractFromScalar(NewScalar(0, 0, 0, 0), src, &dst)
+	if v, _ := At[Vec3s](dst, 0, 1); v != (Vec3s{-10, 20, -30}) {
+		t.Errorf("invalid SubtractFromScalar: %v", v)
+	}
+
+	MultiplyScalar(src, NewScalar(2, 2, 2, 0), &dst)
+	if v, _ := At[Vec3s](dst, 1, 0); v != (Vec3s{20, -40, 60}) {
+		t.Errorf("invalid MultiplyScalar: %v", v)
+	}
+
+	DivideScalarWithParams(src, NewScalar(4, 4, 4, 0), &dst, 1, MatTypeCV64F)
+	if v, _ := At[Vec3d](dst, 1, 1); v != (Vec3d{2.5, -5, 7.5}) {
+		t.Errorf("invalid DivideScalarWithParams: %v", v)
+	}
+
+	Abs(src, &dst)
+	if v, _ := At[Vec3s](dst, 0, 2); v != (Vec3s{10, 20, 30}) {
+		t.Errorf("invalid Abs: %v", v)
+	}
+
+	MaxScalar(src, NewScalar(0, 0, 0, 0), &dst)
+	if v, _ := At[Vec3s](dst, 0, 0); v != (Vec3s{10, 0, 30}) {
+		t.Errorf("invalid MaxScalar: %v", v)
+	}
+
+	MinScalar(src, NewScalar(0, 0, 0, 0), &dst)
+	if v, _ := At[Vec3s](dst, 0, 0); v != (Vec3s{0, -20, 0}) {
+		t.Errorf("invalid MinScalar: %v", v)
+	}
+
+	AbsDiffScalar(src, NewScalar(0, 0, 0, 0), &dst)
+	if v, _ := At[Vec3s](dst, 0, 0); v != (Vec3s{10, 20, 30}) {
+		t.Errorf("invalid AbsDiffScalar: %v", v)
+	}
+}
+
+func TestMatScalarArithmeticE(t *testing.T) {
+	src := NewMatWithSizeFromScalar(NewScalar(10, -20, 30, 0), 2, 3, MatTypeCV16SC3)
+	defer src.Close()
+	mask := NewMatWithSize(3, 3, MatTypeCV8U)
+	defer mask.Close()
+	dst := NewMat()
+	defer dst.Close()
+
+	err := AddScalarWithParamsE(src, NewScalar(1, 2, 3, 0), &dst, mask, -1)
+	var cvErr *OpenCVError
+	if !errors.As(err, &cvErr) {
+		t.Fatalf("AddScalarWithParamsE with mismatched mask should return an OpenCVError, got %v", err)
+	}
+
+	if err := SubtractScalarE(src, NewScalar(10, 10, 10, 0), &dst); err != nil {
+		t.Errorf("SubtractScalarE should not fail: %v", err)
+	}
+	if v, _ := At[Vec3s](dst, 0, 0); v != (Vec3s{0, -30, 20}) {
+		t.Errorf("invalid SubtractScalarE: %v", v)
+	}
+}
+
+func TestMatDivideFromScalar(t *testing.T) {
+	src := NewMatWithSizeFromScalar(NewScalar(4, 0, 0, 0), 2, 2, MatTypeCV32S)
+	defer src.Close()
+	dst := NewMat()
+	defer dst.Close()
+
+	DivideFromScalar(2, src, &dst, MatTypeCV64F)
+	if dst.Type() != MatTypeCV64F || dst.GetDoubleAt(1, 1) != 0.5 {
+		t.Errorf("invalid DivideFromScalar: %v %v", dst.Type(), dst.GetDoubleAt(1, 1))
+	}
+}
+
+func TestMatCompareScalar(t *testing.T) {
+	src := NewMatWithSize(1, 3, MatTypeCV64F)
+	defer src.Close()
+	src.SetDoubleAt(0, 0, 1)
+	src.SetDoubleAt(0, 1, 5)
+	src.SetDoubleAt(0, 2, 10)
+	dst := NewMat()
+	defer dst.Close()
+
+	CompareScalar(src, 5, &dst, CompareGE)
+	if dst.Type() != MatTypeCV8U {
+		t.Fatalf("invalid dst type: %v", dst.Type())
+	}
+	if dst.GetUCharAt(0, 0) != 0 || dst.GetUCharAt(0, 1) != 255 || dst.GetUCharAt(0, 2) != 255 {
+		t.Error("invalid CompareScalar result")
+	}
+}
+
 func TestMatAddWeighted(t *testing.T) {
 	mat1 := NewMatWithSize(101, 102, MatTypeCV8U)
 	defer mat1.Close()
@@ -1281,6 +1495,26 @@ func TestMatDFT(t *testing.T) {
 	}
 }
 
+func TestMatDFTE(t *testing.T) {
+	src := NewMatWithSize(101, 102, MatTypeCV8U)
+	defer src.Close()
+	dst := NewMat()
+	defer dst.Close()
+
+	var cvErr *OpenCVError
+	if err := DFTE(src, &dst, DftForward); !errors.As(err, &cvErr) {
+		t.Fatalf("DFTE of a CV8U Mat should return an OpenCVError, got %v", err)
+	}
+
+	src.ConvertTo(&src, MatTypeCV32F)
+	if err := DFTE(src, &dst, DftForward); err != nil {
+		t.Errorf("DFTE should not fail: %v", err)
+	}
+	if dst.Empty() {
+		t.Error("DFTE dst should not be empty")
+	}
+}
+
 func TestMatDivide(t *testing.T) {
 	mat1 := NewMatWithSize(101, 102, MatTypeCV8U)
 	defer mat1.Close()
@@ -1345,6 +1579,32 @@ func TestMatMulSpectrums(t *testing.T) {
 	}
 }
 
+func TestMulTransposed(t *testing.T) {
+	src := Ones(2, 3, MatTypeCV32F)
+	defer src.Close()
+
+	dst := NewMat()
+	defer dst.Close()
+	MulTransposed(src, &dst, true)
+	if dst.Rows() != 3 || dst.Cols() != 3 || dst.GetFloatAt(1, 2) != 2 {
+		t.Errorf("MulTransposed ata incorrect result: %dx%d", dst.Rows(), dst.Cols())
+	}
+
+	MulTransposed(src, &dst, false)
+	if dst.Rows() != 2 || dst.Cols() != 2 || dst.GetFloatAt(0, 1) != 3 {
+		t.Errorf("MulTransposed aat incorrect result: %dx%d", dst.Rows(), dst.Cols())
+	}
+
+	delta := NewMat()
+	defer delta.Close()
+	dst2 := NewMat()
+	defer dst2.Close()
+	MulTransposedWithParams(src, &dst2, true, delta, 0.5, MatTypeCV64F)
+	if dst2.Type() != MatTypeCV64F || dst2.GetDoubleAt(0, 0) != 1 {
+		t.Errorf("MulTransposedWithParams incorrect result: %v", dst2.Type())
+	}
+}
+
 func TestMatMultiply(t *testing.T) {
 	mat1 := NewMatWithSize(101, 102, MatTypeCV64F)
 	defer mat1.Close()
@@ -1421,6 +1681,28 @@ func TestMatPerspectiveTransform(t *testing.T) {
 	}
 }
 
+func TestPSNR(t *testing.T) {
+	src1 := NewMatWithSize(4, 4, MatTypeCV8U)
+	defer src1.Close()
+	src2 := NewMatWithSizeFromScalar(NewScalar(10, 0, 0, 0), 4, 4, MatTypeCV8U)
+	defer src2.Close()
+
+	expected := 10 * math.Log10(255*255/100.0)
+	if psnr := PSNR(src1, src2); math.Abs(psnr-expected) > 1e-6 {
+		t.Errorf("PSNR incorrect value: %v", psnr)
+	}
+
+	src3 := NewMatWithSize(4, 4, MatTypeCV16U)
+	defer src3.Close()
+	src4 := NewMatWithSizeFromScalar(NewScalar(10, 0, 0, 0), 4, 4, MatTypeCV16U)
+	defer src4.Close()
+
+	expected = 10 * math.Log10(65535*65535/100.0)
+	if psnr := PSNRWithParams(src3, src4, 65535); math.Abs(psnr-expected) > 1e-6 {
+		t.Errorf("PSNRWithParams incorrect value: %v", psnr)
+	}
+}
+
 func TestMatSolve(t *testing.T) {
 	a := NewMatWithSize(3, 3, MatTypeCV32F)
 	defer a.Close()
@@ -1875,6 +2157,40 @@ func TestToCPoints(t *testing.T) {
 	}
 }
 
+func TestToCPoints2f(t *testing.T) {
+	points := []Point2f{
+		{X: 0, Y: 0},
+		{X: 1, Y: 1},
+	}
+
+	cPoints := toCPoints2f(points)
+	if int(cPoints.length) != len(points) {
+		t.Error("Invalid C Points2f length")
+	}
+
+	cPoints = toCPoints2f(nil)
+	if cPoints.length != 0 || cPoints.points != nil {
+		t.Error("Invalid C Points2f for empty slice")
+	}
+}
+
+func TestToCPoints3f(t *testing.T) {
+	points := []Point3f{
+		{X: 0, Y: 0, Z: 0},
+		{X: 1, Y: 1, Z: 1},
+	}
+
+	cPoints := toCPoints3f(points)
+	if int(cPoints.length) != len(points) {
+		t.Error("Invalid C Points3f length")
+	}
+
+	cPoints = toCPoints3f(nil)
+	if cPoints.length != 0 || cPoints.points != nil {
+		t.Error("Invalid C Points3f for empty slice")
+	}
+}
+
 func TestToCStrings(t *testing.T) {
 	strs := []string{
 		"hello",
@@ -2247,6 +2563,29 @@ func TestKMeans(t *testing.T) {
 	}
 }
 
+func TestKMeansE(t *testing.T) {
+	src := NewMatWithSize(4, 4, MatTypeCV32F)
+	defer src.Close()
+	bestLabels := NewMat()
+	defer bestLabels.Close()
+	centers := NewMat()
+	defer centers.Close()
+
+	criteria := NewTermCriteria(Count, 10, 1.0)
+	_, err := KMeansE(src, 10, &bestLabels, criteria, 2, KMeansRandomCenters, &centers)
+	var cvErr *OpenCVError
+	if !errors.As(err, &cvErr) {
+		t.Fatalf("KMeansE with more clusters than samples should return an OpenCVError, got %v", err)
+	}
+
+	if _, err := KMeansE(src, 2, &bestLabels, criteria, 2, KMeansRandomCenters, &centers); err != nil {
+		t.Errorf("KMeansE should not fail: %v", err)
+	}
+	if bestLabels.Empty() {
+		t.Error("KMeansE bestLabels should not be empty")
+	}
+}
+
 func TestKMeansPoints(t *testing.T) {
 	points := []image.Point{
 		image.Pt(0, 0),
@@ -2268,6 +2607,50 @@ func TestKMeansPoints(t *testing.T) {
 	}
 }
 
+func TestPartition(t *testing.T) {
+	values := []int{1, 2, 10, 11, 12, 30}
+
+	labels, nclasses := Partition(len(values), func(i, j int) bool {
+		d := values[i] - values[j]
+		return d >= -1 && d <= 1
+	})
+	if nclasses != 3 {
+		t.Errorf("invalid number of classes: %v", nclasses)
+	}
+	if len(labels) != len(values) {
+		t.Fatalf("invalid number of labels: %v", len(labels))
+	}
+	if labels[0] != labels[1] || labels[2] != labels[3] || labels[3] != labels[4] {
+		t.Errorf("close values should share a label: %v", labels)
+	}
+	if labels[0] == labels[2] || labels[2] == labels[5] || labels[0] == labels[5] {
+		t.Errorf("distant values should not share a label: %v", labels)
+	}
+
+	labels, nclasses = Partition(0, func(i, j int) bool { return true })
+	if nclasses != 0 || len(labels) != 0 {
+		t.Errorf("empty partition should have no classes: %v %v", labels, nclasses)
+	}
+}
+
+func TestPartitionRects(t *testing.T) {
+	rects := []image.Rectangle{
+		image.Rect(0, 0, 10, 10),
+		image.Rect(5, 5, 15, 15),
+		image.Rect(100, 100, 110, 110),
+	}
+
+	labels, nclasses := PartitionRects(rects, func(a, b image.Rectangle) bool {
+		return a.Overlaps(b)
+	})
+	if nclasses != 2 {
+		t.Errorf("invalid number of classes: %v", nclasses)
+	}
+	if labels[0] != labels[1] || labels[0] == labels[2] {
+		t.Errorf("invalid labels: %v", labels)
+	}
+}
+
 func TestMatLog(t *testing.T) {
 	src := NewMatWithSize(4, 3, MatTypeCV32F)
 	defer src.Close()
@@ -2296,6 +2679,28 @@ func TestMatMagnitude(t *testing.T) {
 	}
 }
 
+func TestMahalanobis(t *testing.T) {
+	for _, mt := range []MatType{MatTypeCV32F, MatTypeCV64F} {
+		v1 := NewMatWithSize(1, 2, MatTypeCV64F)
+		defer v1.Close()
+		v1.SetDoubleAt(0, 0, 3)
+		v1.SetDoubleAt(0, 1, 4)
+		v2 := NewMatWithSize(1, 2, MatTypeCV64F)
+		defer v2.Close()
+		icovar := Eye(2, 2, MatTypeCV64F)
+		defer icovar.Close()
+
+		v1.ConvertTo(&v1, mt)
+		v2.ConvertTo(&v2, mt)
+		icovar.ConvertTo(&icovar, mt)
+
+		dist := Mahalanobis(v1, v2, icovar)
+		if math.Abs(dist-5) > 1e-6 {
+			t.Errorf("Mahalanobis incorrect distance for %v: %v", mt, dist)
+		}
+	}
+}
+
 func TestMatMax(t *testing.T) {
 	src1 := NewMatWithSize(4, 4, MatTypeCV32F)
 	defer src1.Close()
@@ -2342,6 +2747,28 @@ func TestMatMinMaxIdx(t *testing.T) {
 	}
 }
 
+func TestMatMinMaxIdxE(t *testing.T) {
+	src := NewMatWithSize(10, 10, MatTypeCV32F)
+	defer src.Close()
+	src.SetFloatAt(3, 3, 17)
+
+	minVal, maxVal, _, maxIdx, err := MinMaxIdxE(src)
+	if err != nil {
+		t.Fatalf("MinMaxIdxE should not fail: %v", err)
+	}
+	if minVal != 0 || maxVal != 17 || maxIdx != 3 {
+		t.Errorf("invalid MinMaxIdxE: %v %v %v", minVal, maxVal, maxIdx)
+	}
+
+	multi := NewMatWithSize(10, 10, MatTypeCV8UC3)
+	defer multi.Close()
+
+	var cvErr *OpenCVError
+	if _, _, _, _, err := MinMaxIdxE(multi); !errors.As(err, &cvErr) {
+		t.Errorf("MinMaxIdxE of a multi-channel Mat should return an OpenCVError, got %v", err)
+	}
+}
+
 func TestMixChannels(t *testing.T) {
 	bgra := NewMatWithSizeFromScalar(NewScalar(255, 0, 0, 255), 10, 10, MatTypeCV8UC4)
 	defer bgra.Close()
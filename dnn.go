@@ -177,12 +177,7 @@ func (net *Net) Forward(outputName string) Mat {
 func (net *Net) ForwardLayers(outBlobNames []string) (blobs []Mat) {
 	cMats := C.struct_Mats{}
 	C.Net_ForwardLayers((C.Net)(net.p), &(cMats), toCStrings(outBlobNames))
-	blobs = make([]Mat, cMats.length)
-	for i := C.int(0); i < cMats.length; i++ {
-		blobs[i].p = C.Mats_get(cMats, i)
-		addMatToProfile(blobs[i].p)
-	}
-	return
+	return toGoMats(cMats)
 }
 
 // SetPreferableBackend ask network to use specific computation backend.
@@ -1,5 +1,5 @@
 module gocv.io/x/gocv
 
-go 1.13
+go 1.18
 
 require github.com/pascaldekloe/goe v0.1.0
@@ -15,6 +15,10 @@ void CvtColor(Mat src, Mat dst, int code) {
     cv::cvtColor(*src, *dst, code);
 }
 
+void CvtColorTwoPlane(Mat src1, Mat src2, Mat dst, int code) {
+    cv::cvtColorTwoPlane(*src1, *src2, *dst, code);
+}
+
 void EqualizeHist(Mat src, Mat dst) {
     cv::equalizeHist(*src, *dst);
 }
@@ -76,10 +80,20 @@ double CompareHist(Mat hist1, Mat hist2, int method) {
     return cv::compareHist(*hist1, *hist2, method);
 }
 
-struct RotatedRect FitEllipse(PointVector pts)
-{
-    cv::RotatedRect bRect = cv::fitEllipse(*pts);
+float EMD(Mat sig1, Mat sig2, int distType) {
+    return cv::EMD(*sig1, *sig2, distType);
+}
 
+float EMDWithParams(Mat sig1, Mat sig2, int distType, Mat cost, float* lowerBound, Mat flow) {
+    if (flow == NULL) {
+        return cv::EMD(*sig1, *sig2, distType, *cost, lowerBound);
+    }
+    return cv::EMD(*sig1, *sig2, distType, *cost, lowerBound, *flow);
+}
+
+// toRotatedRect converts a cv::RotatedRect to a RotatedRect, rounding its
+// coordinates to integers.
+static struct RotatedRect toRotatedRect(const cv::RotatedRect& bRect) {
     Rect r = {bRect.boundingRect().x, bRect.boundingRect().y, bRect.boundingRect().width, bRect.boundingRect().height};
     Point centrpt = {int(lroundf(bRect.center.x)), int(lroundf(bRect.center.y))};
     Size szsz = {int(lroundf(bRect.size.width)), int(lroundf(bRect.size.height))};
@@ -98,6 +112,56 @@ struct RotatedRect FitEllipse(PointVector pts)
     return rotRect;
 }
 
+// toRotatedRect2f converts a cv::RotatedRect to a RotatedRect2f, keeping its
+// subpixel coordinates.
+static struct RotatedRect2f toRotatedRect2f(const cv::RotatedRect& bRect) {
+    cv::Rect br = bRect.boundingRect();
+    Rect r = {br.x, br.y, br.width, br.height};
+    Point2f centrpt = {bRect.center.x, bRect.center.y};
+    Size2f szsz = {bRect.size.width, bRect.size.height};
+
+    cv::Point2f pts4[4];
+    bRect.points(pts4);
+    Point2f* rpts = new Point2f[4];
+    for (size_t j = 0; j < 4; j++) {
+        Point2f pt = {pts4[j].x, pts4[j].y};
+        rpts[j] = pt;
+    }
+
+    RotatedRect2f rotRect = {Points2f{rpts, 4}, r, centrpt, szsz, bRect.angle};
+    return rotRect;
+}
+
+struct RotatedRect FitEllipse(PointVector pts)
+{
+    return toRotatedRect(cv::fitEllipse(*pts));
+}
+
+struct RotatedRect2f FitEllipse2f(Point2fVector pts)
+{
+    return toRotatedRect2f(cv::fitEllipse(*pts));
+}
+
+struct RotatedRect FitEllipseAMS(PointVector pts)
+{
+    return toRotatedRect(cv::fitEllipseAMS(*pts));
+}
+
+struct RotatedRect2f FitEllipseAMS2f(Point2fVector pts)
+{
+    return toRotatedRect2f(cv::fitEllipseAMS(*pts));
+}
+
+struct RotatedRect FitEllipseDirect(PointVector pts)
+{
+    return toRotatedRect(cv::fitEllipseDirect(*pts));
+}
+
+struct RotatedRect2f FitEllipseDirect2f(Point2fVector pts)
+{
+    return toRotatedRect2f(cv::fitEllipseDirect(*pts));
+}
+
 void ConvexHull(PointVector points, Mat hull, bool clockwise, bool returnPoints) {
     cv::convexHull(*points, *hull, clockwise, returnPoints);
 }
@@ -156,6 +220,11 @@ struct Moment Moments(Mat src, bool binaryImage) {
     return mom;
 }
 
+void HuMoments(struct Moment m, double* hu) {
+    cv::Moments mom(m.m00, m.m10, m.m01, m.m20, m.m11, m.m02, m.m30, m.m21, m.m12, m.m03);
+    cv::HuMoments(mom, hu);
+}
+
 void PyrDown(Mat src, Mat dst, Size size, int borderType) {
     cv::Size cvSize(size.width, size.height);
     cv::pyrDown(*src, *dst, cvSize, borderType);
@@ -166,6 +235,22 @@ void PyrUp(Mat src, Mat dst, Size size, int borderType) {
     cv::pyrUp(*src, *dst, cvSize, borderType);
 }
 
+void BuildPyramid(Mat src, struct Mats* dst, int maxLevel, int borderType) {
+    std::vector<cv::Mat> pyramid;
+    cv::buildPyramid(*src, pyramid, maxLevel, borderType);
+    dst->mats = new Mat[pyramid.size()];
+
+    for (size_t i = 0; i < pyramid.size(); ++i) {
+        dst->mats[i] = new cv::Mat(pyramid[i]);
+    }
+
+    dst->length = (int)pyramid.size();
+}
+
+void PyrMeanShiftFiltering(Mat src, Mat dst, double sp, double sr, int maxLevel, TermCriteria termcrit) {
+    cv::pyrMeanShiftFiltering(*src, *dst, sp, sr, maxLevel, *termcrit);
+}
+
 struct Rect BoundingRect(PointVector pts) {
     cv::Rect bRect = cv::boundingRect(*pts);
     Rect r = {bRect.x, bRect.y, bRect.width, bRect.height};
@@ -213,6 +298,39 @@ void MinEnclosingCircle(PointVector pts, Point2f* center, float* radius){
     center->y = center2f.y;
 }
 
+double MinEnclosingTriangle(PointVector pts, Point2fVector triangle) {
+    return cv::minEnclosingTriangle(*pts, *triangle);
+}
+
+double MatchShapes(PointVector contour1, PointVector contour2, int method, double parameter) {
+    return cv::matchShapes(*contour1, *contour2, method, parameter);
+}
+
+double MatchShapesImage(Mat img1, Mat img2, int method, double parameter) {
+    return cv::matchShapes(*img1, *img2, method, parameter);
+}
+
+double PointPolygonTest(PointVector contour, Point2f pt, bool measureDist) {
+    return cv::pointPolygonTest(*contour, cv::Point2f(pt.x, pt.y), measureDist);
+}
+
+bool IsContourConvex(PointVector contour) {
+    return cv::isContourConvex(*contour);
+}
+
+float IntersectConvexConvex(PointVector p1, PointVector p2, Point2fVector p12, bool handleNested) {
+    return cv::intersectConvexConvex(*p1, *p2, *p12, handleNested);
+}
+
+int RotatedRectangleIntersection(RotatedRect rect1, RotatedRect rect2, Point2fVector intersectingRegion) {
+    cv::RotatedRect r1(cv::Point2f(rect1.center.x, rect1.center.y),
+                       cv::Size2f(rect1.size.width, rect1.size.height), rect1.angle);
+    cv::RotatedRect r2(cv::Point2f(rect2.center.x, rect2.center.y),
+                       cv::Size2f(rect2.size.width, rect2.size.height), rect2.angle);
+
+    return cv::rotatedRectangleIntersection(r1, r2, *intersectingRegion);
+}
+
 PointsVector FindContours(Mat src, Mat hierarchy, int mode, int method) {
     PointsVector contours = new std::vector<std::vector<cv::Point> >;
     cv::findContours(*src, *contours, *hierarchy, mode, method);
@@ -258,6 +376,15 @@ Mat GetGaussianKernel(int ksize, double sigma, int ktype){
     return new cv::Mat(cv::getGaussianKernel(ksize, sigma, ktype));
 }
 
+Mat GetGaborKernel(Size ksize, double sigma, double theta, double lambd, double gamma, double psi, int ktype) {
+    cv::Size sz(ksize.width, ksize.height);
+    return new cv::Mat(cv::getGaborKernel(sz, sigma, theta, lambd, gamma, psi, ktype));
+}
+
+void GetDerivKernels(Mat kx, Mat ky, int dx, int dy, int ksize, bool normalize, int ktype) {
+    cv::getDerivKernels(*kx, *ky, dx, dy, ksize, normalize, ktype);
+}
+
 void Laplacian(Mat src, Mat dst, int dDepth, int kSize, double scale, double delta,
                int borderType) {
     cv::Laplacian(*src, *dst, dDepth, kSize, scale, delta, borderType);
@@ -276,6 +403,18 @@ void Canny(Mat src, Mat edges, double t1, double t2) {
     cv::Canny(*src, *edges, t1, t2);
 }
 
+void CornerEigenValsAndVecs(Mat src, Mat dst, int blockSize, int ksize, int borderType) {
+    cv::cornerEigenValsAndVecs(*src, *dst, blockSize, ksize, borderType);
+}
+
+void CornerHarris(Mat src, Mat dst, int blockSize, int ksize, double k, int borderType) {
+    cv::cornerHarris(*src, *dst, blockSize, ksize, k, borderType);
+}
+
+void CornerMinEigenVal(Mat src, Mat dst, int blockSize, int ksize, int borderType) {
+    cv::cornerMinEigenVal(*src, *dst, blockSize, ksize, borderType);
+}
+
 void CornerSubPix(Mat img, Mat corners, Size winSize, Size zeroZone, TermCriteria criteria) {
     cv::Size wsz(winSize.width, winSize.height);
     cv::Size zsz(zeroZone.width, zeroZone.height);
@@ -286,6 +425,10 @@ void GoodFeaturesToTrack(Mat img, Mat corners, int maxCorners, double quality, d
     cv::goodFeaturesToTrack(*img, *corners, maxCorners, quality, minDist);
 }
 
+void PreCornerDetect(Mat src, Mat dst, int ksize, int borderType) {
+    cv::preCornerDetect(*src, *dst, ksize, borderType);
+}
+
 void GrabCut(Mat img, Mat mask, Rect r, Mat bgdModel, Mat fgdModel, int iterCount, int mode) {
     cv::Rect cvRect = cv::Rect(r.x, r.y, r.width, r.height);
     cv::grabCut(*img, *mask, cvRect, *bgdModel, *fgdModel, iterCount, mode);
@@ -340,6 +483,14 @@ void ArrowedLine(Mat img, Point pt1, Point pt2, Scalar color, int thickness) {
     cv::arrowedLine(*img, p1, p2, c, thickness);
 }
 
+void ArrowedLineWithParams(Mat img, Point pt1, Point pt2, Scalar color, int thickness, int lineType, int shift, double tipLength) {
+    cv::Point p1(pt1.x, pt1.y);
+    cv::Point p2(pt2.x, pt2.y);
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::arrowedLine(*img, p1, p2, c, thickness, lineType, shift, tipLength);
+}
+
 bool ClipLine(Size imgSize, Point pt1, Point pt2) {
 	cv::Size sz(imgSize.width, imgSize.height);
 	cv::Point p1(pt1.x, pt1.y);
@@ -355,6 +506,13 @@ void Circle(Mat img, Point center, int radius, Scalar color, int thickness) {
     cv::circle(*img, p1, radius, c, thickness);
 }
 
+void CircleWithParams(Mat img, Point center, int radius, Scalar color, int thickness, int lineType, int shift) {
+    cv::Point p1(center.x, center.y);
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::circle(*img, p1, radius, c, thickness, lineType, shift);
+}
+
 void Ellipse(Mat img, Point center, Point axes, double angle, double
              startAngle, double endAngle, Scalar color, int thickness) {
     cv::Point p1(center.x, center.y);
@@ -364,6 +522,22 @@ void Ellipse(Mat img, Point center, Point axes, double angle, double
     cv::ellipse(*img, p1, p2, angle, startAngle, endAngle, c, thickness);
 }
 
+void EllipseWithParams(Mat img, Point center, Point axes, double angle, double
+             startAngle, double endAngle, Scalar color, int thickness, int lineType, int shift) {
+    cv::Point p1(center.x, center.y);
+    cv::Point p2(axes.x, axes.y);
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::ellipse(*img, p1, p2, angle, startAngle, endAngle, c, thickness, lineType, shift);
+}
+
+PointVector Ellipse2Poly(Point center, Point axes, int angle, int arcStart, int arcEnd, int delta) {
+    PointVector pts = new std::vector<cv::Point>;
+    cv::ellipse2Poly(cv::Point(center.x, center.y), cv::Size(axes.x, axes.y), angle, arcStart, arcEnd, delta, *pts);
+
+    return pts;
+}
+
 void Line(Mat img, Point pt1, Point pt2, Scalar color, int thickness) {
     cv::Point p1(pt1.x, pt1.y);
     cv::Point p2(pt2.x, pt2.y);
@@ -372,6 +546,14 @@ void Line(Mat img, Point pt1, Point pt2, Scalar color, int thickness) {
     cv::line(*img, p1, p2, c, thickness);
 }
 
+void LineWithParams(Mat img, Point pt1, Point pt2, Scalar color, int thickness, int lineType, int shift) {
+    cv::Point p1(pt1.x, pt1.y);
+    cv::Point p2(pt2.x, pt2.y);
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::line(*img, p1, p2, c, thickness, lineType, shift);
+}
+
 void Rectangle(Mat img, Rect r, Scalar color, int thickness) {
     cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
     cv::rectangle(
@@ -384,18 +566,56 @@ void Rectangle(Mat img, Rect r, Scalar color, int thickness) {
     );
 }
 
+void RectangleWithParams(Mat img, Rect r, Scalar color, int thickness, int lineType, int shift) {
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+    cv::rectangle(
+        *img,
+        cv::Point(r.x, r.y),
+        cv::Point(r.x + r.width, r.y + r.height),
+        c,
+        thickness,
+        lineType,
+        shift
+    );
+}
+
 void FillPoly(Mat img, PointsVector pts, Scalar color) {
     cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
 
     cv::fillPoly(*img, *pts, c);
 }
 
+void FillPolyWithParams(Mat img, PointsVector pts, Scalar color, int lineType, int shift, Point offset) {
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::fillPoly(*img, *pts, c, lineType, shift, cv::Point(offset.x, offset.y));
+}
+
+void FillConvexPoly(Mat img, PointVector pts, Scalar color, int lineType, int shift) {
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::fillConvexPoly(*img, *pts, c, lineType, shift);
+}
+
 void Polylines(Mat img, PointsVector pts, bool isClosed, Scalar color,int thickness) {
     cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
 
     cv::polylines(*img, *pts, isClosed, c, thickness);
 }
 
+void PolylinesWithParams(Mat img, PointsVector pts, bool isClosed, Scalar color, int thickness, int lineType, int shift) {
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::polylines(*img, *pts, isClosed, c, thickness, lineType, shift);
+}
+
+void DrawMarker(Mat img, Point position, Scalar color, int markerType, int markerSize, int thickness, int lineType) {
+    cv::Point p(position.x, position.y);
+    cv::Scalar c = cv::Scalar(color.val1, color.val2, color.val3, color.val4);
+
+    cv::drawMarker(*img, p, c, markerType, markerSize, thickness, lineType);
+}
+
 struct Size GetTextSize(const char* text, int fontFace, double fontScale, int thickness) {
     return GetTextSizeWithBaseline(text, fontFace, fontScale, thickness, NULL);
 }
@@ -420,6 +640,10 @@ void PutTextWithParams(Mat img, const char* text, Point org, int fontFace, doubl
     cv::putText(*img, text, pt, fontFace, fontScale, c, thickness, lineType, bottomLeftOrigin);
 }
 
+double GetFontScaleFromHeight(int fontFace, int pixelHeight, int thickness) {
+    return cv::getFontScaleFromHeight(fontFace, pixelHeight, thickness);
+}
+
 void Resize(Mat src, Mat dst, Size dsize, double fx, double fy, int interp) {
     cv::Size sz(dsize.width, dsize.height);
     cv::resize(*src, *dst, sz, fx, fy, interp);
@@ -457,6 +681,39 @@ void Watershed(Mat image, Mat markers) {
     cv::watershed(*image, *markers);
 }
 
+int FloodFill(Mat image, Point seedPoint, Scalar newVal, Rect* rect, Scalar loDiff, Scalar upDiff, int flags) {
+    cv::Point seed(seedPoint.x, seedPoint.y);
+    cv::Scalar val = cv::Scalar(newVal.val1, newVal.val2, newVal.val3, newVal.val4);
+    cv::Scalar lo = cv::Scalar(loDiff.val1, loDiff.val2, loDiff.val3, loDiff.val4);
+    cv::Scalar up = cv::Scalar(upDiff.val1, upDiff.val2, upDiff.val3, upDiff.val4);
+    cv::Rect r;
+
+    int area = cv::floodFill(*image, seed, val, &r, lo, up, flags);
+
+    rect->x = r.x;
+    rect->y = r.y;
+    rect->width = r.width;
+    rect->height = r.height;
+    return area;
+}
+
+int FloodFillWithMask(Mat image, Mat mask, Point seedPoint, Scalar newVal, Rect* rect, Scalar loDiff,
+                      Scalar upDiff, int flags) {
+    cv::Point seed(seedPoint.x, seedPoint.y);
+    cv::Scalar val = cv::Scalar(newVal.val1, newVal.val2, newVal.val3, newVal.val4);
+    cv::Scalar lo = cv::Scalar(loDiff.val1, loDiff.val2, loDiff.val3, loDiff.val4);
+    cv::Scalar up = cv::Scalar(upDiff.val1, upDiff.val2, upDiff.val3, upDiff.val4);
+    cv::Rect r;
+
+    int area = cv::floodFill(*image, *mask, seed, val, &r, lo, up, flags);
+
+    rect->x = r.x;
+    rect->y = r.y;
+    rect->width = r.width;
+    rect->height = r.height;
+    return area;
+}
+
 void ApplyColorMap(Mat src, Mat dst, int colormap) {
     cv::applyColorMap(*src, *dst, colormap);
 }
@@ -516,6 +773,10 @@ void Remap(Mat src, Mat dst, Mat map1, Mat map2, int interpolation, int borderMo
         cv::remap(*src, *dst, *map1, *map2, interpolation, borderMode, c);
 }
 
+void ConvertMaps(Mat map1, Mat map2, Mat dstmap1, Mat dstmap2, int dstmap1type, bool nnInterpolation) {
+    cv::convertMaps(*map1, *map2, *dstmap1, *dstmap2, dstmap1type, nnInterpolation);
+}
+
 void Filter2D(Mat src, Mat dst, int ddepth, Mat kernel, Point anchor, double delta, int borderType) {
         cv::Point anchorPt(anchor.x, anchor.y);
         cv::filter2D(*src, *dst, ddepth, *kernel, anchorPt, delta, borderType);
@@ -557,6 +818,37 @@ void CLAHE_Apply(CLAHE c, Mat src, Mat dst) {
     (*c)->apply(*src, *dst);
 }
 
+LineSegmentDetector LineSegmentDetector_Create() {
+    return new cv::Ptr<cv::LineSegmentDetector>(cv::createLineSegmentDetector());
+}
+
+LineSegmentDetector LineSegmentDetector_CreateWithParams(int refine, double scale, double sigmaScale, double quant,
+        double angTh, double logEps, double densityTh, int nBins) {
+    return new cv::Ptr<cv::LineSegmentDetector>(cv::createLineSegmentDetector(refine, scale, sigmaScale, quant,
+        angTh, logEps, densityTh, nBins));
+}
+
+void LineSegmentDetector_Close(LineSegmentDetector lsd) {
+    delete lsd;
+}
+
+void LineSegmentDetector_Detect(LineSegmentDetector lsd, Mat src, Mat lines) {
+    (*lsd)->detect(*src, *lines);
+}
+
+void LineSegmentDetector_DetectWithParams(LineSegmentDetector lsd, Mat src, Mat lines, Mat width, Mat prec, Mat nfa) {
+    (*lsd)->detect(*src, *lines, *width, *prec, *nfa);
+}
+
+void LineSegmentDetector_DrawSegments(LineSegmentDetector lsd, Mat img, Mat lines) {
+    (*lsd)->drawSegments(*img, *lines);
+}
+
+int LineSegmentDetector_CompareSegments(LineSegmentDetector lsd, Size size, Mat lines1, Mat lines2, Mat img) {
+    cv::Size sz(size.width, size.height);
+    return (*lsd)->compareSegments(sz, *lines1, *lines2, *img);
+}
+
 void InvertAffineTransform(Mat src, Mat dst) {
 	cv::invertAffineTransform(*src, *dst);
 }
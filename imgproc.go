@@ -62,6 +62,18 @@ func CvtColor(src Mat, dst *Mat, code ColorConversionCode) {
 	C.CvtColor(src.p, dst.p, C.int(code))
 }
 
+// CvtColorTwoPlane converts an image from a two-plane YUV format, such as
+// NV12 or NV21, to RGB or BGR. src1 is the MatTypeCV8UC1 Y plane and src2
+// the MatTypeCV8UC2 interleaved UV plane, of half the width and height of
+// src1. NewMatsFromTwoPlaneYUV returns such planes without copying them.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d7/d1b/group__imgproc__misc.html#ga8e873314e72a1a6c0252375538fbf753
+//
+func CvtColorTwoPlane(src1 Mat, src2 Mat, dst *Mat, code ColorConversionCode) {
+	C.CvtColorTwoPlane(src1.p, src2.p, dst.p, C.int(code))
+}
+
 // EqualizeHist normalizes the brightness and increases the contrast of the image.
 //
 // For further details, please see:
@@ -181,6 +193,57 @@ func CompareHist(hist1 Mat, hist2 Mat, method HistCompMethod) float32 {
 	return float32(C.CompareHist(hist1.p, hist2.p, C.int(method)))
 }
 
+// EMD computes the Earth Mover's Distance, a minimal work distance between
+// two weighted point configurations, such as histograms or signatures.
+//
+// Each signature is a MatTypeCV32F Mat, where each row is the point weight
+// followed by its coordinates. The weights must be non-negative and have at
+// least one non-zero value.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/dc7/group__imgproc__hist.html#ga902b8e60cc7075c8947345489221e0e0
+//
+func EMD(signature1, signature2 Mat, distType DistanceTypes) float32 {
+	return float32(C.EMD(signature1.p, signature2.p, C.int(distType)))
+}
+
+// EMDWithParams computes the Earth Mover's Distance like EMD, with
+// additional optional parameters.
+//
+// cost is the user-defined MatTypeCV32F cost matrix of size
+// signature1.Rows() x signature2.Rows(), required with DistUser and which
+// must be empty otherwise. Signatures then only contain weights.
+//
+// lowerBound is a lower boundary of the distance between the two
+// signatures, that is the distance between their mass centers. If the
+// distance between mass centers is greater or equal to *lowerBound, the EMD
+// is not computed. In any case, *lowerBound is set to the distance between
+// mass centers on return, unless a cost matrix is used. Pass nil to always
+// compute the EMD.
+//
+// flow, if not nil, receives the signature1.Rows() x signature2.Rows()
+// MatTypeCV32F flow matrix, where each element is the flow from a point of
+// signature1 to a point of signature2.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/dc7/group__imgproc__hist.html#ga902b8e60cc7075c8947345489221e0e0
+//
+func EMDWithParams(signature1, signature2 Mat, distType DistanceTypes, cost Mat, lowerBound *float32, flow *Mat) float32 {
+	var cLowerBound *C.float
+	if lowerBound != nil {
+		lb := C.float(*lowerBound)
+		cLowerBound = &lb
+		defer func() { *lowerBound = float32(lb) }()
+	}
+
+	var cFlow C.Mat
+	if flow != nil {
+		cFlow = flow.p
+	}
+
+	return float32(C.EMDWithParams(signature1.p, signature2.p, C.int(distType), cost.p, cLowerBound, cFlow))
+}
+
 // ClipLine clips the line against the image rectangle.
 // For further details, please see:
 // https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#gaf483cb46ad6b049bc35ec67052ef1c2c
@@ -443,6 +506,17 @@ type RotatedRect struct {
 	Angle        float64
 }
 
+// RotatedRect2f is a RotatedRect with subpixel coordinates, as returned by
+// the functions that fit a shape to a Point2fVector.
+type RotatedRect2f struct {
+	Points       []Point2f
+	BoundingRect image.Rectangle
+	Center       Point2f
+	Width        float32
+	Height       float32
+	Angle        float64
+}
+
 // toPoints converts C.Contour to []image.Points
 //
 func toPoints(points C.Contour) []image.Point {
@@ -491,6 +565,77 @@ func FitEllipse(pts PointVector) RotatedRect {
 	cRect := C.FitEllipse(pts.p)
 	defer C.Points_Close(cRect.pts)
 
+	return toRotatedRect(cRect)
+}
+
+// FitEllipse2f Fits an ellipse around a set of subpixel 2D points.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#gaf259efaad93098103d6c27b9e4900ffa
+//
+func FitEllipse2f(pts Point2fVector) RotatedRect2f {
+	cRect := C.FitEllipse2f(pts.p)
+	defer C.Points2f_Close(cRect.pts)
+
+	return toRotatedRect2f(cRect)
+}
+
+// FitEllipseAMS fits an ellipse around a set of 2D points using the
+// Approximate Mean Square (AMS) method, which is more stable than FitEllipse
+// for noisy points or partial arcs.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga69e90cda55c4e192a8caa0b99c3e4550
+//
+func FitEllipseAMS(pts PointVector) RotatedRect {
+	cRect := C.FitEllipseAMS(pts.p)
+	defer C.Points_Close(cRect.pts)
+
+	return toRotatedRect(cRect)
+}
+
+// FitEllipseAMS2f fits an ellipse around a set of subpixel 2D points using
+// the Approximate Mean Square (AMS) method.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga69e90cda55c4e192a8caa0b99c3e4550
+//
+func FitEllipseAMS2f(pts Point2fVector) RotatedRect2f {
+	cRect := C.FitEllipseAMS2f(pts.p)
+	defer C.Points2f_Close(cRect.pts)
+
+	return toRotatedRect2f(cRect)
+}
+
+// FitEllipseDirect fits an ellipse around a set of 2D points using the
+// Direct least square method, which always returns an ellipse, even for
+// points on a partial arc.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga6421884fd411923a74891998bbe9e813
+//
+func FitEllipseDirect(pts PointVector) RotatedRect {
+	cRect := C.FitEllipseDirect(pts.p)
+	defer C.Points_Close(cRect.pts)
+
+	return toRotatedRect(cRect)
+}
+
+// FitEllipseDirect2f fits an ellipse around a set of subpixel 2D points using
+// the Direct least square method.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga6421884fd411923a74891998bbe9e813
+//
+func FitEllipseDirect2f(pts Point2fVector) RotatedRect2f {
+	cRect := C.FitEllipseDirect2f(pts.p)
+	defer C.Points2f_Close(cRect.pts)
+
+	return toRotatedRect2f(cRect)
+}
+
+// toRotatedRect converts a C RotatedRect to a RotatedRect.
+func toRotatedRect(cRect C.struct_RotatedRect) RotatedRect {
 	return RotatedRect{
 		Points:       toPoints(cRect.pts),
 		BoundingRect: image.Rect(int(cRect.boundingRect.x), int(cRect.boundingRect.y), int(cRect.boundingRect.x)+int(cRect.boundingRect.width), int(cRect.boundingRect.y)+int(cRect.boundingRect.height)),
@@ -499,7 +644,24 @@ func FitEllipse(pts PointVector) RotatedRect {
 		Height:       int(cRect.size.height),
 		Angle:        float64(cRect.angle),
 	}
+}
+
+// toRotatedRect2f converts a C RotatedRect2f to a RotatedRect2f.
+func toRotatedRect2f(cRect C.struct_RotatedRect2f) RotatedRect2f {
+	cPoints := unsafe.Slice((*C.Point2f)(unsafe.Pointer(cRect.pts.points)), int(cRect.pts.length))
+	points := make([]Point2f, len(cPoints))
+	for i, pt := range cPoints {
+		points[i] = fromCPoint2f(pt)
+	}
 
+	return RotatedRect2f{
+		Points:       points,
+		BoundingRect: image.Rect(int(cRect.boundingRect.x), int(cRect.boundingRect.y), int(cRect.boundingRect.x)+int(cRect.boundingRect.width), int(cRect.boundingRect.y)+int(cRect.boundingRect.height)),
+		Center:       fromCPoint2f(cRect.center),
+		Width:        float32(cRect.size.width),
+		Height:       float32(cRect.size.height),
+		Angle:        float64(cRect.angle),
+	}
 }
 
 // MinEnclosingCircle finds a circle of the minimum area enclosing the input 2D point set.
@@ -515,6 +677,155 @@ func MinEnclosingCircle(pts PointVector) (x, y, radius float32) {
 	return x, y, radius
 }
 
+// MinEnclosingTriangle finds a triangle of minimum area enclosing the
+// input 2D point set, and returns its vertices and its area.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga1513e72f6bbdfc370563664f71e0542f
+//
+func MinEnclosingTriangle(pts PointVector) (triangle []Point2f, area float64) {
+	cTriangle := NewPoint2fVector()
+	defer cTriangle.Close()
+
+	area = float64(C.MinEnclosingTriangle(pts.p, cTriangle.p))
+	return cTriangle.ToPoints(), area
+}
+
+// ShapeMatchModes are the comparison methods of MatchShapes, that are
+// based on the Hu invariants of the two shapes.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html
+//
+type ShapeMatchModes int
+
+const (
+	// ContoursMatchI1 sums the absolute differences of the inverses of the
+	// log-scaled Hu invariants.
+	ContoursMatchI1 ShapeMatchModes = 1
+
+	// ContoursMatchI2 sums the absolute differences of the log-scaled Hu
+	// invariants.
+	ContoursMatchI2 ShapeMatchModes = 2
+
+	// ContoursMatchI3 is the maximum relative difference of the log-scaled
+	// Hu invariants.
+	ContoursMatchI3 ShapeMatchModes = 3
+)
+
+// MatchShapes compares two contours, and returns 0 for identical shapes
+// and a greater value the more they differ.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#gaadc90cb16e2362c9bd6e7363e6e4c317
+//
+func MatchShapes(contour1 PointVector, contour2 PointVector, method ShapeMatchModes, parameter float64) float64 {
+	return float64(C.MatchShapes(contour1.p, contour2.p, C.int(method), C.double(parameter)))
+}
+
+// MatchShapesImage compares the shapes of two single-channel images, using
+// the moments of their non-zero pixels, and returns 0 for identical shapes
+// and a greater value the more they differ.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#gaadc90cb16e2362c9bd6e7363e6e4c317
+//
+func MatchShapesImage(img1 Mat, img2 Mat, method ShapeMatchModes, parameter float64) float64 {
+	return float64(C.MatchShapesImage(img1.p, img2.p, C.int(method), C.double(parameter)))
+}
+
+// PointPolygonTest determines whether the point is inside, outside or on
+// an edge of the contour. It returns a positive value inside, a negative
+// value outside and zero on an edge. With measureDist, the value is the
+// signed distance to the nearest contour edge, otherwise it is +1, -1 or 0.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga1a539e8db2135af2566103705d7a5722
+//
+func PointPolygonTest(contour PointVector, pt Point2f, measureDist bool) float64 {
+	cPt := C.struct_Point2f{
+		x: C.float(pt.X),
+		y: C.float(pt.Y),
+	}
+	return float64(C.PointPolygonTest(contour.p, cPt, C.bool(measureDist)))
+}
+
+// IsContourConvex tests a contour convexity. The contour must be simple,
+// that is without self-intersections.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga8abf8010377b58cbc16db6734d92941b
+//
+func IsContourConvex(contour PointVector) bool {
+	return bool(C.IsContourConvex(contour.p))
+}
+
+// IntersectConvexConvex finds the intersection of two convex polygons, and
+// returns its area and its vertices. With handleNested, a polygon fully
+// enclosed in the other one is returned as the intersection.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga8e840f3f3695613d32c052bec89e782c
+//
+func IntersectConvexConvex(p1 PointVector, p2 PointVector, handleNested bool) (area float32, intersection []Point2f) {
+	p12 := NewPoint2fVector()
+	defer p12.Close()
+
+	area = float32(C.IntersectConvexConvex(p1.p, p2.p, p12.p, C.bool(handleNested)))
+	return area, p12.ToPoints()
+}
+
+// RectanglesIntersectTypes are the kinds of intersection of
+// RotatedRectangleIntersection.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html
+//
+type RectanglesIntersectTypes int
+
+const (
+	// IntersectNone means that there is no intersection.
+	IntersectNone RectanglesIntersectTypes = 0
+
+	// IntersectPartial means that there is a partial intersection.
+	IntersectPartial RectanglesIntersectTypes = 1
+
+	// IntersectFull means that one of the rectangles is fully enclosed in the other.
+	IntersectFull RectanglesIntersectTypes = 2
+)
+
+// RotatedRectangleIntersection finds out if there is any intersection
+// between two rotated rectangles, and returns the vertices of the
+// intersecting region. Only the Center, Width, Height and Angle of the
+// rectangles are used.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#ga8740e7645628c59d238b0b22c2abe2d4
+//
+func RotatedRectangleIntersection(rect1 RotatedRect, rect2 RotatedRect) (RectanglesIntersectTypes, []Point2f) {
+	region := NewPoint2fVector()
+	defer region.Close()
+
+	ret := C.RotatedRectangleIntersection(toCRotatedRect(rect1), toCRotatedRect(rect2), region.p)
+	return RectanglesIntersectTypes(ret), region.ToPoints()
+}
+
+// toCRotatedRect converts the center, size and angle of a RotatedRect to
+// its C representation.
+func toCRotatedRect(r RotatedRect) C.struct_RotatedRect {
+	return C.struct_RotatedRect{
+		center: C.struct_Point{
+			x: C.int(r.Center.X),
+			y: C.int(r.Center.Y),
+		},
+		size: C.struct_Size{
+			width:  C.int(r.Width),
+			height: C.int(r.Height),
+		},
+		angle: C.double(r.Angle),
+	}
+}
+
 // FindContours finds contours in a binary image.
 //
 // For further details, please see:
@@ -678,6 +989,35 @@ func Moments(src Mat, binaryImage bool) map[string]float64 {
 	return result
 }
 
+// HuMoments calculates the seven Hu invariants from the moments returned
+// by Moments. They are invariant to translation, scale and rotation, and
+// the seventh one changes sign under reflection.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/dc0/group__imgproc__shape.html#gab001db45c1f1af6cbdbe64df04c4e944
+//
+func HuMoments(moments map[string]float64) (hu [7]float64) {
+	m := C.struct_Moment{
+		m00: C.double(moments["m00"]),
+		m10: C.double(moments["m10"]),
+		m01: C.double(moments["m01"]),
+		m20: C.double(moments["m20"]),
+		m11: C.double(moments["m11"]),
+		m02: C.double(moments["m02"]),
+		m30: C.double(moments["m30"]),
+		m21: C.double(moments["m21"]),
+		m12: C.double(moments["m12"]),
+		m03: C.double(moments["m03"]),
+	}
+
+	var cHu [7]C.double
+	C.HuMoments(m, &cHu[0])
+	for i, v := range cHu {
+		hu[i] = float64(v)
+	}
+	return hu
+}
+
 // PyrDown blurs an image and downsamples it.
 //
 // For further details, please see:
@@ -704,6 +1044,31 @@ func PyrUp(src Mat, dst *Mat, ksize image.Point, borderType BorderType) {
 	C.PyrUp(src.p, dst.p, pSize, C.int(borderType))
 }
 
+// BuildPyramid constructs the Gaussian pyramid of an image, by calling
+// PyrDown repeatedly. It returns maxLevel+1 Mats, the first of which is a
+// copy of src. The returned Mats must be closed by the caller.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d86/group__imgproc__filter.html#gacfdda2bc1ac55e96de7e9f0bce7238c0
+//
+func BuildPyramid(src Mat, maxLevel int, borderType BorderType) (pyramid []Mat) {
+	cMats := C.struct_Mats{}
+	C.BuildPyramid(src.p, &(cMats), C.int(maxLevel), C.int(borderType))
+	return toGoMats(cMats)
+}
+
+// PyrMeanShiftFiltering performs the initial step of mean-shift segmentation
+// of a MatTypeCV8UC3 image, which flattens color gradients and fine-grain
+// texture. sp is the spatial window radius, sr the color window radius, and
+// maxLevel the maximum level of the pyramid used for the segmentation.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d86/group__imgproc__filter.html#ga9fabdce9543bd602445f5db3827e4cc0
+//
+func PyrMeanShiftFiltering(src Mat, dst *Mat, sp, sr float64, maxLevel int, termcrit TermCriteria) {
+	C.PyrMeanShiftFiltering(src.p, dst.p, C.double(sp), C.double(sr), C.int(maxLevel), termcrit.p)
+}
+
 // MorphologyDefaultBorder returns "magic" border value for erosion and dilation.
 // It is automatically transformed to Scalar::all(-DBL_MAX) for dilation.
 //
@@ -857,6 +1222,35 @@ func GetGaussianKernelWithParams(ksize int, sigma float64, ktype MatType) Mat {
 	return newMat(C.GetGaussianKernel(C.int(ksize), C.double(sigma), C.int(ktype)))
 }
 
+// GetGaborKernel returns Gabor filter coefficients, of size ksize and type
+// ktype, which must be MatTypeCV32F or MatTypeCV64F. sigma is the standard
+// deviation of the gaussian envelope, theta the orientation of the normal to
+// the parallel stripes, lambd the wavelength of the sinusoidal factor, gamma
+// the spatial aspect ratio and psi the phase offset.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d86/group__imgproc__filter.html#gae84c92d248183bd92fa713ce51cc3599
+//
+func GetGaborKernel(ksize image.Point, sigma, theta, lambd, gamma, psi float64, ktype MatType) Mat {
+	pSize := C.struct_Size{
+		width:  C.int(ksize.X),
+		height: C.int(ksize.Y),
+	}
+	return newMat(C.GetGaborKernel(pSize, C.double(sigma), C.double(theta), C.double(lambd), C.double(gamma), C.double(psi), C.int(ktype)))
+}
+
+// GetDerivKernels returns the filter coefficients for computing spatial
+// image derivatives of order dx and dy, to be used with SepFilter2D. If
+// normalize is true, the coefficients are normalized for floating point
+// images. ktype must be MatTypeCV32F or MatTypeCV64F.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d86/group__imgproc__filter.html#ga6d6c23f7bd3f5836c31cfae994fc4aea
+//
+func GetDerivKernels(kx, ky *Mat, dx, dy, ksize int, normalize bool, ktype MatType) {
+	C.GetDerivKernels(kx.p, ky.p, C.int(dx), C.int(dy), C.int(ksize), C.bool(normalize), C.int(ktype))
+}
+
 // Sobel calculates the first, second, third, or mixed image derivatives using an extended Sobel operator
 //
 // For further details, please see:
@@ -919,6 +1313,42 @@ func Canny(src Mat, edges *Mat, t1 float32, t2 float32) {
 	C.Canny(src.p, edges.p, C.double(t1), C.double(t2))
 }
 
+// CornerEigenValsAndVecs calculates eigenvalues and eigenvectors of image
+// blocks for corner detection. For every pixel, it considers a
+// blockSize x blockSize neighborhood and stores (λ1, λ2, x1, y1, x2, y2) in a
+// 6-channel 32-bit float dst, where λ1, λ2 are the non-sorted eigenvalues of the
+// covariation matrix of derivatives, and (x1, y1), (x2, y2) the corresponding
+// eigenvectors.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#ga4055896d9ef77dd3cacf2c5f60e13f1c
+//
+func CornerEigenValsAndVecs(src Mat, dst *Mat, blockSize int, ksize int, borderType BorderType) {
+	C.CornerEigenValsAndVecs(src.p, dst.p, C.int(blockSize), C.int(ksize), C.int(borderType))
+}
+
+// CornerHarris runs the Harris corner detector on the image. The Harris
+// detector response is stored in a MatTypeCV32F dst of the same size as src.
+// Corners can be found as the local maxima of this response map.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#gac1fc3598018010880e370e2f709b4345
+//
+func CornerHarris(src Mat, dst *Mat, blockSize int, ksize int, k float64, borderType BorderType) {
+	C.CornerHarris(src.p, dst.p, C.int(blockSize), C.int(ksize), C.double(k), C.int(borderType))
+}
+
+// CornerMinEigenVal calculates the minimal eigenvalue of gradient matrices
+// for corner detection, and stores it in a MatTypeCV32F dst of the same size
+// as src.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#ga3dbce297c1feb859ee36707e1003e0a8
+//
+func CornerMinEigenVal(src Mat, dst *Mat, blockSize int, ksize int, borderType BorderType) {
+	C.CornerMinEigenVal(src.p, dst.p, C.int(blockSize), C.int(ksize), C.int(borderType))
+}
+
 // CornerSubPix Refines the corner locations. The function iterates to find
 // the sub-pixel accurate location of corners or radial saddle points.
 //
@@ -950,6 +1380,17 @@ func GoodFeaturesToTrack(img Mat, corners *Mat, maxCorners int, quality float64,
 	C.GoodFeaturesToTrack(img.p, corners.p, C.int(maxCorners), C.double(quality), C.double(minDist))
 }
 
+// PreCornerDetect calculates a feature map for corner detection, and stores
+// it in a MatTypeCV32F dst of the same size as src. Corners can be found as
+// the local maxima of this feature map.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#gaa819f39b5c994871774081803ae22586
+//
+func PreCornerDetect(src Mat, dst *Mat, ksize int, borderType BorderType) {
+	C.PreCornerDetect(src.p, dst.p, C.int(ksize), C.int(borderType))
+}
+
 // GrabCutMode is the flag for GrabCut algorithm.
 type GrabCutMode int
 
@@ -1157,6 +1598,35 @@ func ArrowedLine(img *Mat, pt1 image.Point, pt2 image.Point, c color.RGBA, thick
 	C.ArrowedLine(img.p, sp1, sp2, sColor, C.int(thickness))
 }
 
+// ArrowedLineWithParams draws a arrow segment pointing from the first point
+// to the second one, with the given line type. shift is the number of
+// fractional bits in the point coordinates, and tipLength the length of the
+// arrow tip relative to the arrow length.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga0a165a3ca093fd488ac709fdf10c05b2
+//
+func ArrowedLineWithParams(img *Mat, pt1 image.Point, pt2 image.Point, c color.RGBA, thickness int, lineType LineType, shift int, tipLength float64) {
+	sp1 := C.struct_Point{
+		x: C.int(pt1.X),
+		y: C.int(pt1.Y),
+	}
+
+	sp2 := C.struct_Point{
+		x: C.int(pt2.X),
+		y: C.int(pt2.Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.ArrowedLineWithParams(img.p, sp1, sp2, sColor, C.int(thickness), C.int(lineType), C.int(shift), C.double(tipLength))
+}
+
 // Circle draws a circle.
 //
 // For further details, please see:
@@ -1178,6 +1648,28 @@ func Circle(img *Mat, center image.Point, radius int, c color.RGBA, thickness in
 	C.Circle(img.p, pc, C.int(radius), sColor, C.int(thickness))
 }
 
+// CircleWithParams draws a circle with the given line type. shift is the
+// number of fractional bits in the center coordinates and in the radius.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#gaf10604b069374903dbd0f0488cb43670
+//
+func CircleWithParams(img *Mat, center image.Point, radius int, c color.RGBA, thickness int, lineType LineType, shift int) {
+	pc := C.struct_Point{
+		x: C.int(center.X),
+		y: C.int(center.Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.CircleWithParams(img.p, pc, C.int(radius), sColor, C.int(thickness), C.int(lineType), C.int(shift))
+}
+
 // Ellipse draws a simple or thick elliptic arc or fills an ellipse sector.
 //
 // For further details, please see:
@@ -1203,6 +1695,52 @@ func Ellipse(img *Mat, center, axes image.Point, angle, startAngle, endAngle flo
 	C.Ellipse(img.p, pc, pa, C.double(angle), C.double(startAngle), C.double(endAngle), sColor, C.int(thickness))
 }
 
+// EllipseWithParams draws a simple or thick elliptic arc or fills an ellipse
+// sector, with the given line type. shift is the number of fractional bits
+// in the center coordinates and in the axes.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga28b2267d35786f5f890ca167236cbc69
+//
+func EllipseWithParams(img *Mat, center, axes image.Point, angle, startAngle, endAngle float64, c color.RGBA, thickness int, lineType LineType, shift int) {
+	pc := C.struct_Point{
+		x: C.int(center.X),
+		y: C.int(center.Y),
+	}
+	pa := C.struct_Point{
+		x: C.int(axes.X),
+		y: C.int(axes.Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.EllipseWithParams(img.p, pc, pa, C.double(angle), C.double(startAngle), C.double(endAngle), sColor, C.int(thickness), C.int(lineType), C.int(shift))
+}
+
+// Ellipse2Poly approximates an elliptic arc with a polyline, whose
+// consecutive vertices are delta degrees apart. Angles are in degrees.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga727a72a3f6a625a2ae035f957c61051f
+//
+func Ellipse2Poly(center, axes image.Point, angle, arcStart, arcEnd, delta int) PointVector {
+	pc := C.struct_Point{
+		x: C.int(center.X),
+		y: C.int(center.Y),
+	}
+	pa := C.struct_Point{
+		x: C.int(axes.X),
+		y: C.int(axes.Y),
+	}
+
+	return PointVector{p: C.Ellipse2Poly(pc, pa, C.int(angle), C.int(arcStart), C.int(arcEnd), C.int(delta))}
+}
+
 // Line draws a line segment connecting two points.
 //
 // For further details, please see:
@@ -1229,6 +1767,34 @@ func Line(img *Mat, pt1 image.Point, pt2 image.Point, c color.RGBA, thickness in
 	C.Line(img.p, sp1, sp2, sColor, C.int(thickness))
 }
 
+// LineWithParams draws a line segment connecting two points, with the given
+// line type. shift is the number of fractional bits in the point
+// coordinates.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga7078a9fae8c7e7d13d24dac2520ae4a2
+//
+func LineWithParams(img *Mat, pt1 image.Point, pt2 image.Point, c color.RGBA, thickness int, lineType LineType, shift int) {
+	sp1 := C.struct_Point{
+		x: C.int(pt1.X),
+		y: C.int(pt1.Y),
+	}
+
+	sp2 := C.struct_Point{
+		x: C.int(pt2.X),
+		y: C.int(pt2.Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.LineWithParams(img.p, sp1, sp2, sColor, C.int(thickness), C.int(lineType), C.int(shift))
+}
+
 // Rectangle draws a simple, thick, or filled up-right rectangle.
 // It renders a rectangle with the desired characteristics to the target Mat image.
 //
@@ -1253,6 +1819,31 @@ func Rectangle(img *Mat, r image.Rectangle, c color.RGBA, thickness int) {
 	C.Rectangle(img.p, cRect, sColor, C.int(thickness))
 }
 
+// RectangleWithParams draws a simple, thick, or filled up-right rectangle,
+// with the given line type. shift is the number of fractional bits in the
+// rectangle coordinates.
+//
+// For further details, please see:
+// http://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga346ac30b5c74e9b5137576c9ee9e0e8c
+//
+func RectangleWithParams(img *Mat, r image.Rectangle, c color.RGBA, thickness int, lineType LineType, shift int) {
+	cRect := C.struct_Rect{
+		x:      C.int(r.Min.X),
+		y:      C.int(r.Min.Y),
+		width:  C.int(r.Size().X),
+		height: C.int(r.Size().Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.RectangleWithParams(img.p, cRect, sColor, C.int(thickness), C.int(lineType), C.int(shift))
+}
+
 // FillPoly fills the area bounded by one or more polygons.
 //
 // For more information, see:
@@ -1268,6 +1859,46 @@ func FillPoly(img *Mat, pts PointsVector, c color.RGBA) {
 	C.FillPoly(img.p, pts.p, sColor)
 }
 
+// FillPolyWithParams fills the area bounded by one or more polygons, with
+// the given line type. shift is the number of fractional bits in the vertex
+// coordinates, and offset is added to all the vertices.
+//
+// For more information, see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#gaf30888828337aa4c6b56782b5dfbd4b7
+func FillPolyWithParams(img *Mat, pts PointsVector, c color.RGBA, lineType LineType, shift int, offset image.Point) {
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	pOffset := C.struct_Point{
+		x: C.int(offset.X),
+		y: C.int(offset.Y),
+	}
+
+	C.FillPolyWithParams(img.p, pts.p, sColor, C.int(lineType), C.int(shift), pOffset)
+}
+
+// FillConvexPoly fills a convex polygon, which is much faster than
+// FillPoly. It can also fill polygons whose contour crosses any horizontal
+// line at most twice. shift is the number of fractional bits in the vertex
+// coordinates.
+//
+// For more information, see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga906aae1606ea4ed2f27bec1537f6c5c2
+func FillConvexPoly(img *Mat, pts PointVector, c color.RGBA, lineType LineType, shift int) {
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.FillConvexPoly(img.p, pts.p, sColor, C.int(lineType), C.int(shift))
+}
+
 // Polylines draws several polygonal curves.
 //
 // For more information, see:
@@ -1283,6 +1914,63 @@ func Polylines(img *Mat, pts PointsVector, isClosed bool, c color.RGBA, thicknes
 	C.Polylines(img.p, pts.p, C.bool(isClosed), sColor, C.int(thickness))
 }
 
+// PolylinesWithParams draws several polygonal curves, with the given line
+// type. shift is the number of fractional bits in the vertex coordinates.
+//
+// For more information, see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga1ea127ffbbb7e0bfc4fd6fd2eb64263c
+func PolylinesWithParams(img *Mat, pts PointsVector, isClosed bool, c color.RGBA, thickness int, lineType LineType, shift int) {
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.PolylinesWithParams(img.p, pts.p, C.bool(isClosed), sColor, C.int(thickness), C.int(lineType), C.int(shift))
+}
+
+// MarkerType are the marker shapes drawn by DrawMarker.
+type MarkerType int
+
+const (
+	// MarkerCross is a crosshair marker shape.
+	MarkerCross MarkerType = 0
+	// MarkerTiltedCross is a 45 degree tilted crosshair marker shape.
+	MarkerTiltedCross MarkerType = 1
+	// MarkerStar is a star marker shape, combination of cross and tilted cross.
+	MarkerStar MarkerType = 2
+	// MarkerDiamond is a diamond marker shape.
+	MarkerDiamond MarkerType = 3
+	// MarkerSquare is a square marker shape.
+	MarkerSquare MarkerType = 4
+	// MarkerTriangleUp is an upwards pointing triangle marker shape.
+	MarkerTriangleUp MarkerType = 5
+	// MarkerTriangleDown is a downwards pointing triangle marker shape.
+	MarkerTriangleDown MarkerType = 6
+)
+
+// DrawMarker draws a marker of the given type and size, in pixels, on a
+// predefined position in an image.
+//
+// For more information, see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga482fa7b0f578fcdd8a174904592a6250
+func DrawMarker(img *Mat, position image.Point, c color.RGBA, markerType MarkerType, markerSize int, thickness int, lineType LineType) {
+	pPosition := C.struct_Point{
+		x: C.int(position.X),
+		y: C.int(position.Y),
+	}
+
+	sColor := C.struct_Scalar{
+		val1: C.double(c.B),
+		val2: C.double(c.G),
+		val3: C.double(c.R),
+		val4: C.double(c.A),
+	}
+
+	C.DrawMarker(img.p, pPosition, sColor, C.int(markerType), C.int(markerSize), C.int(thickness), C.int(lineType))
+}
+
 // HersheyFont are the font libraries included in OpenCV.
 // Only a subset of the available Hershey fonts are supported by OpenCV.
 //
@@ -1419,6 +2107,16 @@ func PutTextWithParams(img *Mat, text string, org image.Point, fontFace HersheyF
 	return
 }
 
+// GetFontScaleFromHeight calculates the font scale to use with PutText to
+// achieve a given height in pixels.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d6/d6e/group__imgproc__draw.html#ga442ff925c1a957794a1309e0ed3ba2c3
+//
+func GetFontScaleFromHeight(fontFace HersheyFont, pixelHeight int, thickness int) float64 {
+	return float64(C.GetFontScaleFromHeight(C.int(fontFace), C.int(pixelHeight), C.int(thickness)))
+}
+
 // InterpolationFlags are bit flags that control the interpolation algorithm
 // that is used.
 type InterpolationFlags int
@@ -1546,6 +2244,77 @@ func Watershed(image Mat, markers *Mat) {
 	C.Watershed(image.p, markers.p)
 }
 
+// FloodFillFlags are the operation flags of FloodFill, that are combined
+// with the connectivity.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d7/d1b/group__imgproc__misc.html
+//
+type FloodFillFlags int
+
+const (
+	// FloodFillFixedRange considers the difference between the current pixel
+	// and the seed pixel, instead of between neighbor pixels.
+	FloodFillFixedRange FloodFillFlags = 1 << 16
+
+	// FloodFillMaskOnly only fills the mask, without changing the image.
+	// It is only meaningful with FloodFillWithMask.
+	FloodFillMaskOnly FloodFillFlags = 1 << 17
+)
+
+// FloodFill fills a connected component with the given color, starting
+// from the seed point. A pixel is added to the component if its value is
+// within loDiff below and upDiff above the value of a neighbor pixel already
+// in the component, or of the seed pixel with FloodFillFixedRange, for each
+// channel. connectivity is 4 or 8.
+//
+// It returns the number of filled pixels and the bounding rectangle of the
+// filled component.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d7/d1b/group__imgproc__misc.html#gaf1f55a048f8a45bc3383586e80b1f0d0
+//
+func FloodFill(img *Mat, seedPoint image.Point, newVal Scalar, loDiff, upDiff Scalar,
+	connectivity int, flags FloodFillFlags) (area int, rect image.Rectangle) {
+	cSeed := C.struct_Point{
+		x: C.int(seedPoint.X),
+		y: C.int(seedPoint.Y),
+	}
+	var r C.struct_Rect
+
+	area = int(C.FloodFill(img.p, cSeed, toCScalar(newVal), &r, toCScalar(loDiff), toCScalar(upDiff),
+		C.int(connectivity|int(flags))))
+	rect = image.Rect(int(r.x), int(r.y), int(r.x+r.width), int(r.y+r.height))
+	return
+}
+
+// FloodFillWithMask fills a connected component like FloodFill, but only
+// where mask is zero. mask must be a single-channel 8-bit Mat 2 pixels wider
+// and taller than img, with pixel (x+1, y+1) of the mask matching pixel (x, y)
+// of img. The filled pixels of the mask are set to newMaskVal, or to 1 if
+// newMaskVal is 0. With FloodFillMaskOnly, img is left unchanged.
+//
+// It returns the number of filled pixels and the bounding rectangle of the
+// filled component.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d7/d1b/group__imgproc__misc.html#gaf1f55a048f8a45bc3383586e80b1f0d0
+//
+func FloodFillWithMask(img *Mat, mask *Mat, seedPoint image.Point, newVal Scalar, loDiff, upDiff Scalar,
+	connectivity int, newMaskVal uint8, flags FloodFillFlags) (area int, rect image.Rectangle) {
+	cSeed := C.struct_Point{
+		x: C.int(seedPoint.X),
+		y: C.int(seedPoint.Y),
+	}
+	var r C.struct_Rect
+
+	cFlags := connectivity | int(newMaskVal)<<8 | int(flags)
+	area = int(C.FloodFillWithMask(img.p, mask.p, cSeed, toCScalar(newVal), &r, toCScalar(loDiff),
+		toCScalar(upDiff), C.int(cFlags)))
+	rect = image.Rect(int(r.x), int(r.y), int(r.x+r.width), int(r.y+r.height))
+	return
+}
+
 // ColormapTypes are the 12 GNU Octave/MATLAB equivalent colormaps.
 //
 // For further details, please see:
@@ -1671,6 +2440,64 @@ func Remap(src Mat, dst, map1, map2 *Mat, interpolation InterpolationFlags, bord
 	C.Remap(src.p, dst.p, map1.p, map2.p, C.int(interpolation), C.int(borderMode), bv)
 }
 
+// ConvertMaps converts image transformation maps from one representation to
+// another, typically from floating point maps to the faster fixed-point
+// MatTypeCV16SC2 representation. If nnInterpolation is true, the maps are
+// only used for nearest-neighbor interpolation and dstmap2 is left empty.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d54/group__imgproc__transform.html#ga9156732fa8f01be9ebd1a194f2728b7f
+//
+func ConvertMaps(map1, map2 Mat, dstmap1, dstmap2 *Mat, dstmap1type MatType, nnInterpolation bool) {
+	C.ConvertMaps(map1.p, map2.p, dstmap1.p, dstmap2.p, C.int(dstmap1type), C.bool(nnInterpolation))
+}
+
+// Remapper holds prepared Remap maps, to apply the same geometrical
+// transformation to successive images, such as the frames of a video.
+//
+// The maps are converted once to the fixed-point representation, which is
+// much faster to apply than floating point maps.
+type Remapper struct {
+	map1          Mat
+	map2          Mat
+	interpolation InterpolationFlags
+	borderMode    BorderType
+	borderValue   color.RGBA
+}
+
+// NewRemapper returns a new Remapper for the maps map1 and map2, as computed
+// by InitUndistortRectifyMap or InitWideAngleProjMap. The maps are copied,
+// so they can be closed once the Remapper is created.
+func NewRemapper(map1, map2 Mat, interpolation InterpolationFlags, borderMode BorderType, borderValue color.RGBA) Remapper {
+	r := Remapper{
+		map1:          NewMat(),
+		map2:          NewMat(),
+		interpolation: interpolation,
+		borderMode:    borderMode,
+		borderValue:   borderValue,
+	}
+
+	if map1.Type() == MatTypeCV16SC2 {
+		map1.CopyTo(&r.map1)
+		map2.CopyTo(&r.map2)
+	} else {
+		ConvertMaps(map1, map2, &r.map1, &r.map2, MatTypeCV16SC2, interpolation == InterpolationNearestNeighbor)
+	}
+	return r
+}
+
+// Apply applies the transformation of the Remapper to src.
+func (r *Remapper) Apply(src Mat, dst *Mat) {
+	Remap(src, dst, &r.map1, &r.map2, r.interpolation, r.borderMode, r.borderValue)
+}
+
+// Close closes the maps of the Remapper.
+func (r *Remapper) Close() error {
+	r.map1.Close()
+	r.map2.Close()
+	return nil
+}
+
 // Filter2D applies an arbitrary linear filter to an image.
 //
 // For further details, please see:
@@ -1726,7 +2553,7 @@ func LinearPolar(src Mat, dst *Mat, center image.Point, maxRadius float64, flags
 type DistanceTypes int
 
 const (
-	DistUser   DistanceTypes = 0
+	DistUser   DistanceTypes = -1
 	DistL1     DistanceTypes = 1
 	DistL2     DistanceTypes = 2
 	DistC      DistanceTypes = 3
@@ -1788,6 +2615,111 @@ func (c *CLAHE) Apply(src Mat, dst *Mat) {
 	C.CLAHE_Apply((C.CLAHE)(c.p), src.p, dst.p)
 }
 
+// LineSegmentDetectorModes are the refinement modes of a LineSegmentDetector.
+type LineSegmentDetectorModes int
+
+const (
+	// LsdRefineNone applies no refinement.
+	LsdRefineNone LineSegmentDetectorModes = 0
+
+	// LsdRefineStd applies standard refinement, e.g. breaking arches into
+	// smaller straighter line approximations.
+	LsdRefineStd LineSegmentDetectorModes = 1
+
+	// LsdRefineAdv applies advanced refinement. The number of false alarms is
+	// calculated, and the lines are refined through increase of precision,
+	// decrement in size, etc.
+	LsdRefineAdv LineSegmentDetectorModes = 2
+)
+
+// LineSegmentDetector is a wrapper around the cv::LineSegmentDetector algorithm,
+// which finds line segments in a grayscale image without any parameter tuning.
+type LineSegmentDetector struct {
+	// C.LineSegmentDetector
+	p unsafe.Pointer
+}
+
+// NewLineSegmentDetector returns a new LineSegmentDetector algorithm
+// with the standard refinement mode.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#ga6b2ad2353c337c42551b521a73eeae7d
+//
+func NewLineSegmentDetector() LineSegmentDetector {
+	return LineSegmentDetector{p: unsafe.Pointer(C.LineSegmentDetector_Create())}
+}
+
+// NewLineSegmentDetectorWithParams returns a new LineSegmentDetector algorithm.
+//
+// scale is the scale of the image used to find the lines, sigmaScale the
+// sigma for the Gaussian filter computed as sigma = sigmaScale/scale, quant
+// the bound to the quantization error on the gradient norm, angTh the
+// gradient angle tolerance in degrees, logEps the detection threshold (only
+// used with LsdRefineAdv), densityTh the minimal density of aligned region
+// points in the enclosing rectangle, and nBins the number of bins in the
+// pseudo-ordering of gradient modulus.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html#ga6b2ad2353c337c42551b521a73eeae7d
+//
+func NewLineSegmentDetectorWithParams(refine LineSegmentDetectorModes, scale, sigmaScale, quant, angTh, logEps, densityTh float64, nBins int) LineSegmentDetector {
+	return LineSegmentDetector{p: unsafe.Pointer(C.LineSegmentDetector_CreateWithParams(C.int(refine), C.double(scale),
+		C.double(sigmaScale), C.double(quant), C.double(angTh), C.double(logEps), C.double(densityTh), C.int(nBins)))}
+}
+
+// Close LineSegmentDetector.
+func (lsd *LineSegmentDetector) Close() error {
+	C.LineSegmentDetector_Close((C.LineSegmentDetector)(lsd.p))
+	lsd.p = nil
+	return nil
+}
+
+// Detect finds the line segments in the MatTypeCV8UC1 src image. Each line
+// segment is stored in lines as a Vec4f (x1, y1, x2, y2) of its end points.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html
+//
+func (lsd *LineSegmentDetector) Detect(src Mat, lines *Mat) {
+	C.LineSegmentDetector_Detect((C.LineSegmentDetector)(lsd.p), src.p, lines.p)
+}
+
+// DetectWithParams finds the line segments in the MatTypeCV8UC1 src image
+// like Detect, and also returns the width of the regions where the lines are
+// found, their precision (the angle tolerance used for the region growing)
+// and, with LsdRefineAdv only, their -log10(NFA) (number of false alarms).
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html
+//
+func (lsd *LineSegmentDetector) DetectWithParams(src Mat, lines, width, prec, nfa *Mat) {
+	C.LineSegmentDetector_DetectWithParams((C.LineSegmentDetector)(lsd.p), src.p, lines.p, width.p, prec.p, nfa.p)
+}
+
+// DrawSegments draws the line segments found by Detect on img.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html
+//
+func (lsd *LineSegmentDetector) DrawSegments(img *Mat, lines Mat) {
+	C.LineSegmentDetector_DrawSegments((C.LineSegmentDetector)(lsd.p), img.p, lines.p)
+}
+
+// CompareSegments draws two groups of line segments in blue and red on a
+// BGR img of the given size, and returns the number of non-overlapping
+// pixels.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/dd/d1a/group__imgproc__feature.html
+//
+func (lsd *LineSegmentDetector) CompareSegments(size image.Point, lines1, lines2 Mat, img *Mat) int {
+	sz := C.struct_Size{
+		width:  C.int(size.X),
+		height: C.int(size.Y),
+	}
+	return int(C.LineSegmentDetector_CompareSegments((C.LineSegmentDetector)(lsd.p), sz, lines1.p, lines2.p, img.p))
+}
+
 func InvertAffineTransform(src Mat, dst *Mat) {
 	C.InvertAffineTransform(src.p, dst.p)
 }
@@ -1855,46 +2787,181 @@ func (m *Mat) ToImage() (image.Image, error) {
 
 // ToImageYUV converts a Mat to a image.YCbCr using image.YCbCrSubsampleRatio420 as default subsampling param.
 func (m *Mat) ToImageYUV() (*image.YCbCr, error) {
-	img, err := m.ToImage()
+	return m.ToImageYUVWithParams(image.YCbCrSubsampleRatio420)
+}
+
+// ToImageYUVWithParams converts a Mat to a image.YCbCr using provided YUV subsample ratio param.
+// The conversion is done by OpenCV, directly into the planes of the image.YCbCr.
+func (m *Mat) ToImageYUVWithParams(ratio image.YCbCrSubsampleRatio) (*image.YCbCr, error) {
+	if m.Empty() {
+		return nil, errors.New("ToImageYUV does not support empty Mats")
+	}
+
+	bgr := NewMat()
+	defer bgr.Close()
+
+	src := *m
+	switch m.Type() {
+	case MatTypeCV8UC1:
+		CvtColor(*m, &bgr, ColorGrayToBGR)
+		src = bgr
+	case MatTypeCV8UC3:
+	case MatTypeCV8UC4:
+		CvtColor(*m, &bgr, ColorBGRAToBGR)
+		src = bgr
+	default:
+		return nil, errors.New("ToImageYUV supports only MatType CV8UC1, CV8UC3 and CV8UC4")
+	}
+
+	ycrcb := NewMat()
+	defer ycrcb.Close()
+	CvtColor(src, &ycrcb, ColorBGRToYCrCb)
+
+	planes := Split(ycrcb)
+	defer func() {
+		for _, p := range planes {
+			p.Close()
+		}
+	}()
+
+	img := image.NewYCbCr(image.Rect(0, 0, m.Cols(), m.Rows()), ratio)
+	yMat, cbMat, crMat, err := newYCbCrPlaneMats(img.Rect, ratio, img.Y, img.YStride, img.Cb, img.Cr, img.CStride)
 	if err != nil {
 		return nil, err
 	}
-	bounds := img.Bounds()
-	converted := image.NewYCbCr(bounds, image.YCbCrSubsampleRatio420)
+	defer yMat.Close()
+	defer cbMat.Close()
+	defer crMat.Close()
 
-	for row := 0; row < bounds.Max.Y; row++ {
-		for col := 0; col < bounds.Max.X; col++ {
-			r, g, b, _ := img.At(col, row).RGBA()
-			y, cb, cr := color.RGBToYCbCr(uint8(r), uint8(g), uint8(b))
+	planes[0].CopyTo(&yMat)
+	if cbMat.Rows() == m.Rows() && cbMat.Cols() == m.Cols() {
+		planes[2].CopyTo(&cbMat)
+		planes[1].CopyTo(&crMat)
+	} else {
+		sz := image.Pt(cbMat.Cols(), cbMat.Rows())
+		Resize(planes[2], &cbMat, sz, 0, 0, InterpolationArea)
+		Resize(planes[1], &crMat, sz, 0, 0, InterpolationArea)
+	}
+	return img, nil
+}
 
-			converted.Y[converted.YOffset(col, row)] = y
-			converted.Cb[converted.COffset(col, row)] = cb
-			converted.Cr[converted.COffset(col, row)] = cr
+// ImageYCbCrToMat converts an image.YCbCr of any subsample ratio to a
+// MatTypeCV8UC3 BGR Mat. The conversion is done by OpenCV, directly from the
+// planes of the image.YCbCr, without an intermediate RGBA image.
+func ImageYCbCrToMat(img *image.YCbCr) (Mat, error) {
+	r := img.Rect
+	yMat, cbMat, crMat, err := newYCbCrPlaneMats(r, img.SubsampleRatio, img.Y, img.YStride, img.Cb, img.Cr, img.CStride)
+	if err != nil {
+		return NewMat(), err
+	}
+	defer yMat.Close()
+	defer cbMat.Close()
+	defer crMat.Close()
+
+	fx, fy := yCbCrSubsampleFactors(img.SubsampleRatio)
+	if fx != 1 || fy != 1 {
+		// replicate each chroma sample over the luma samples it covers, then
+		// crop to the image, which may not be aligned on chroma samples.
+		c := yCbCrChromaRect(r, img.SubsampleRatio)
+		crop := image.Rect(0, 0, r.Dx(), r.Dy()).Add(r.Min.Sub(image.Pt(c.Min.X*fx, c.Min.Y*fy)))
+		for _, p := range []*Mat{&cbMat, &crMat} {
+			up := NewMat()
+			Resize(*p, &up, image.Pt(c.Dx()*fx, c.Dy()*fy), 0, 0, InterpolationNearestNeighbor)
+			p.Close()
+			*p = up.Region(crop)
+			up.Close()
 		}
 	}
-	return converted, nil
+
+	ycrcb := NewMat()
+	defer ycrcb.Close()
+	Merge([]Mat{yMat, crMat, cbMat}, &ycrcb)
+
+	dst := NewMat()
+	CvtColor(ycrcb, &dst, ColorYCrCbToBGR)
+	return dst, nil
 }
 
-// ToImageYUV converts a Mat to a image.YCbCr using provided YUV subsample ratio param.
-func (m *Mat) ToImageYUVWithParams(ratio image.YCbCrSubsampleRatio) (*image.YCbCr, error) {
-	img, err := m.ToImage()
+// NewMatsFromYUVPlanes returns MatTypeCV8UC1 Mats that reference the Y, U
+// and V planes of a planar YUV image, such as I420, without copying them.
+// The U and V planes are subsampled according to ratio, and share the same
+// uvStride.
+//
+// The planes must not be modified or released while the Mats are in use.
+func NewMatsFromYUVPlanes(width, height int, ratio image.YCbCrSubsampleRatio, y []byte, yStride int, u, v []byte, uvStride int) (yMat, uMat, vMat Mat, err error) {
+	return newYCbCrPlaneMats(image.Rect(0, 0, width, height), ratio, y, yStride, u, v, uvStride)
+}
+
+// NewMatsFromTwoPlaneYUV returns Mats that reference the planes of a
+// two-plane 4:2:0 YUV image, such as NV12 or NV21, without copying them:
+// a MatTypeCV8UC1 Mat for the Y plane and a MatTypeCV8UC2 Mat of half the
+// width and height for the interleaved UV plane. width and height must be
+// even. The Mats can be converted with CvtColorTwoPlane.
+//
+// The planes must not be modified or released while the Mats are in use.
+func NewMatsFromTwoPlaneYUV(width, height int, y []byte, yStride int, uv []byte, uvStride int) (yMat, uvMat Mat, err error) {
+	if width%2 != 0 || height%2 != 0 {
+		return Mat{}, Mat{}, errors.New("two-plane YUV width and height must be even")
+	}
+
+	yMat, err = NewMatFromBytesWithStep(height, width, MatTypeCV8UC1, y, yStride)
 	if err != nil {
-		return nil, err
+		return Mat{}, Mat{}, err
 	}
-	bounds := img.Bounds()
-	converted := image.NewYCbCr(bounds, ratio)
+	uvMat, err = NewMatFromBytesWithStep(height/2, width/2, MatTypeCV8UC2, uv, uvStride)
+	if err != nil {
+		yMat.Close()
+		return Mat{}, Mat{}, err
+	}
+	return yMat, uvMat, nil
+}
 
-	for row := 0; row < bounds.Max.Y; row++ {
-		for col := 0; col < bounds.Max.X; col++ {
-			r, g, b, _ := img.At(col, row).RGBA()
-			y, cb, cr := color.RGBToYCbCr(uint8(r), uint8(g), uint8(b))
+// newYCbCrPlaneMats returns Mats that reference the Y, Cb and Cr planes of
+// an image of bounds r, laid out like an image.YCbCr.
+func newYCbCrPlaneMats(r image.Rectangle, ratio image.YCbCrSubsampleRatio, y []byte, yStride int, cb, cr []byte, cStride int) (yMat, cbMat, crMat Mat, err error) {
+	c := yCbCrChromaRect(r, ratio)
 
-			converted.Y[converted.YOffset(col, row)] = y
-			converted.Cb[converted.COffset(col, row)] = cb
-			converted.Cr[converted.COffset(col, row)] = cr
-		}
+	yMat, err = NewMatFromBytesWithStep(r.Dy(), r.Dx(), MatTypeCV8UC1, y, yStride)
+	if err != nil {
+		return Mat{}, Mat{}, Mat{}, err
 	}
-	return converted, nil
+	cbMat, err = NewMatFromBytesWithStep(c.Dy(), c.Dx(), MatTypeCV8UC1, cb, cStride)
+	if err != nil {
+		yMat.Close()
+		return Mat{}, Mat{}, Mat{}, err
+	}
+	crMat, err = NewMatFromBytesWithStep(c.Dy(), c.Dx(), MatTypeCV8UC1, cr, cStride)
+	if err != nil {
+		yMat.Close()
+		cbMat.Close()
+		return Mat{}, Mat{}, Mat{}, err
+	}
+	return yMat, cbMat, crMat, nil
+}
+
+// yCbCrSubsampleFactors returns the horizontal and vertical chroma
+// subsampling factors of ratio.
+func yCbCrSubsampleFactors(ratio image.YCbCrSubsampleRatio) (fx, fy int) {
+	switch ratio {
+	case image.YCbCrSubsampleRatio422:
+		return 2, 1
+	case image.YCbCrSubsampleRatio420:
+		return 2, 2
+	case image.YCbCrSubsampleRatio440:
+		return 1, 2
+	case image.YCbCrSubsampleRatio411:
+		return 4, 1
+	case image.YCbCrSubsampleRatio410:
+		return 4, 2
+	}
+	return 1, 1
+}
+
+// yCbCrChromaRect returns the bounds of the chroma planes of an image of
+// bounds r, in chroma samples, the same way as image.NewYCbCr.
+func yCbCrChromaRect(r image.Rectangle, ratio image.YCbCrSubsampleRatio) image.Rectangle {
+	fx, fy := yCbCrSubsampleFactors(ratio)
+	return image.Rect(r.Min.X/fx, r.Min.Y/fy, (r.Max.X+fx-1)/fx, (r.Max.Y+fy-1)/fy)
 }
 
 // ImageToMatRGBA converts image.Image to gocv.Mat,
@@ -10,8 +10,10 @@ extern "C" {
 
 #ifdef __cplusplus
 typedef cv::Ptr<cv::CLAHE>* CLAHE;
+typedef cv::Ptr<cv::LineSegmentDetector>* LineSegmentDetector;
 #else
 typedef void* CLAHE;
+typedef void* LineSegmentDetector;
 #endif
 
 #include "core.h"
@@ -19,10 +21,13 @@ typedef void* CLAHE;
 double ArcLength(PointVector curve, bool is_closed);
 PointVector ApproxPolyDP(PointVector curve, double epsilon, bool closed);
 void CvtColor(Mat src, Mat dst, int code);
+void CvtColorTwoPlane(Mat src1, Mat src2, Mat dst, int code);
 void EqualizeHist(Mat src, Mat dst);
 void CalcHist(struct Mats mats, IntVector chans, Mat mask, Mat hist, IntVector sz, FloatVector rng, bool acc);
 void CalcBackProject(struct Mats mats, IntVector chans, Mat hist, Mat backProject, FloatVector rng, bool uniform);
 double CompareHist(Mat hist1, Mat hist2, int method);
+float EMD(Mat sig1, Mat sig2, int distType);
+float EMDWithParams(Mat sig1, Mat sig2, int distType, Mat cost, float* lowerBound, Mat flow);
 void ConvexHull(PointVector points, Mat hull, bool clockwise, bool returnPoints);
 void ConvexityDefects(PointVector points, Mat hull, Mat result);
 void BilateralFilter(Mat src, Mat dst, int d, double sc, double ss);
@@ -35,20 +40,37 @@ void Erode(Mat src, Mat dst, Mat kernel);
 void ErodeWithParams(Mat src, Mat dst, Mat kernel, Point anchor, int iterations, int borderType);
 void MatchTemplate(Mat image, Mat templ, Mat result, int method, Mat mask);
 struct Moment Moments(Mat src, bool binaryImage);
+void HuMoments(struct Moment m, double* hu);
 void PyrDown(Mat src, Mat dst, Size dstsize, int borderType);
 void PyrUp(Mat src, Mat dst, Size dstsize, int borderType);
+void BuildPyramid(Mat src, struct Mats* dst, int maxLevel, int borderType);
+void PyrMeanShiftFiltering(Mat src, Mat dst, double sp, double sr, int maxLevel, TermCriteria termcrit);
 struct Rect BoundingRect(PointVector pts);
 void BoxPoints(RotatedRect rect, Mat boxPts);
 double ContourArea(PointVector pts);
 struct RotatedRect MinAreaRect(PointVector pts);
 struct RotatedRect FitEllipse(PointVector pts);
+struct RotatedRect2f FitEllipse2f(Point2fVector pts);
+struct RotatedRect FitEllipseAMS(PointVector pts);
+struct RotatedRect2f FitEllipseAMS2f(Point2fVector pts);
+struct RotatedRect FitEllipseDirect(PointVector pts);
+struct RotatedRect2f FitEllipseDirect2f(Point2fVector pts);
 void MinEnclosingCircle(PointVector pts, Point2f* center, float* radius);
+double MinEnclosingTriangle(PointVector pts, Point2fVector triangle);
+double MatchShapes(PointVector contour1, PointVector contour2, int method, double parameter);
+double MatchShapesImage(Mat img1, Mat img2, int method, double parameter);
+double PointPolygonTest(PointVector contour, Point2f pt, bool measureDist);
+bool IsContourConvex(PointVector contour);
+float IntersectConvexConvex(PointVector p1, PointVector p2, Point2fVector p12, bool handleNested);
+int RotatedRectangleIntersection(RotatedRect rect1, RotatedRect rect2, Point2fVector intersectingRegion);
 PointsVector FindContours(Mat src, Mat hierarchy, int mode, int method);
 int ConnectedComponents(Mat src, Mat dst, int connectivity, int ltype, int ccltype);
 int ConnectedComponentsWithStats(Mat src, Mat labels, Mat stats, Mat centroids, int connectivity, int ltype, int ccltype);
 
 void GaussianBlur(Mat src, Mat dst, Size ps, double sX, double sY, int bt);
 Mat GetGaussianKernel(int ksize, double sigma, int ktype);
+Mat GetGaborKernel(Size ksize, double sigma, double theta, double lambd, double gamma, double psi, int ktype);
+void GetDerivKernels(Mat kx, Mat ky, int dx, int dy, int ksize, bool normalize, int ktype);
 void Laplacian(Mat src, Mat dst, int dDepth, int kSize, double scale, double delta, int borderType);
 void Scharr(Mat src, Mat dst, int dDepth, int dx, int dy, double scale, double delta,
             int borderType);
@@ -59,8 +81,12 @@ void MorphologyExWithParams(Mat src, Mat dst, int op, Mat kernel, Point pt, int
 void MedianBlur(Mat src, Mat dst, int ksize);
 
 void Canny(Mat src, Mat edges, double t1, double t2);
+void CornerEigenValsAndVecs(Mat src, Mat dst, int blockSize, int ksize, int borderType);
+void CornerHarris(Mat src, Mat dst, int blockSize, int ksize, double k, int borderType);
+void CornerMinEigenVal(Mat src, Mat dst, int blockSize, int ksize, int borderType);
 void CornerSubPix(Mat img, Mat corners, Size winSize, Size zeroZone, TermCriteria criteria);
 void GoodFeaturesToTrack(Mat img, Mat corners, int maxCorners, double quality, double minDist);
+void PreCornerDetect(Mat src, Mat dst, int ksize, int borderType);
 void GrabCut(Mat img, Mat mask, Rect rect, Mat bgdModel, Mat fgdModel, int iterCount, int mode);
 void HoughCircles(Mat src, Mat circles, int method, double dp, double minDist);
 void HoughCirclesWithParams(Mat src, Mat circles, int method, double dp, double minDist,
@@ -77,19 +103,31 @@ void AdaptiveThreshold(Mat src, Mat dst, double maxValue, int adaptiveTyp, int t
                        double c);
 
 void ArrowedLine(Mat img, Point pt1, Point pt2, Scalar color, int thickness);
+void ArrowedLineWithParams(Mat img, Point pt1, Point pt2, Scalar color, int thickness, int lineType, int shift, double tipLength);
 void Circle(Mat img, Point center, int radius, Scalar color, int thickness);
+void CircleWithParams(Mat img, Point center, int radius, Scalar color, int thickness, int lineType, int shift);
 void Ellipse(Mat img, Point center, Point axes, double angle, double
              startAngle, double endAngle, Scalar color, int thickness);
+void EllipseWithParams(Mat img, Point center, Point axes, double angle, double
+             startAngle, double endAngle, Scalar color, int thickness, int lineType, int shift);
+PointVector Ellipse2Poly(Point center, Point axes, int angle, int arcStart, int arcEnd, int delta);
 void Line(Mat img, Point pt1, Point pt2, Scalar color, int thickness);
+void LineWithParams(Mat img, Point pt1, Point pt2, Scalar color, int thickness, int lineType, int shift);
 void Rectangle(Mat img, Rect rect, Scalar color, int thickness);
+void RectangleWithParams(Mat img, Rect rect, Scalar color, int thickness, int lineType, int shift);
 void FillPoly(Mat img, PointsVector points, Scalar color);
+void FillPolyWithParams(Mat img, PointsVector points, Scalar color, int lineType, int shift, Point offset);
+void FillConvexPoly(Mat img, PointVector points, Scalar color, int lineType, int shift);
 void Polylines(Mat img, PointsVector points, bool isClosed, Scalar color, int thickness);
+void PolylinesWithParams(Mat img, PointsVector points, bool isClosed, Scalar color, int thickness, int lineType, int shift);
+void DrawMarker(Mat img, Point position, Scalar color, int markerType, int markerSize, int thickness, int lineType);
 struct Size GetTextSize(const char* text, int fontFace, double fontScale, int thickness);
 struct Size GetTextSizeWithBaseline(const char* text, int fontFace, double fontScale, int thickness, int* baseline);
 void PutText(Mat img, const char* text, Point org, int fontFace, double fontScale,
              Scalar color, int thickness);
 void PutTextWithParams(Mat img, const char* text, Point org, int fontFace, double fontScale,
                          Scalar color, int thickness, int lineType, bool bottomLeftOrigin);
+double GetFontScaleFromHeight(int fontFace, int pixelHeight, int thickness);
 void Resize(Mat src, Mat dst, Size sz, double fx, double fy, int interp);
 void GetRectSubPix(Mat src, Size patchSize, Point center, Mat dst);
 Mat GetRotationMatrix2D(Point center, double angle, double scale);
@@ -98,6 +136,9 @@ void WarpAffineWithParams(Mat src, Mat dst, Mat rot_mat, Size dsize, int flags,
                           Scalar borderValue);
 void WarpPerspective(Mat src, Mat dst, Mat m, Size dsize);
 void Watershed(Mat image, Mat markers);
+int FloodFill(Mat image, Point seedPoint, Scalar newVal, Rect* rect, Scalar loDiff, Scalar upDiff, int flags);
+int FloodFillWithMask(Mat image, Mat mask, Point seedPoint, Scalar newVal, Rect* rect, Scalar loDiff,
+                      Scalar upDiff, int flags);
 void ApplyColorMap(Mat src, Mat dst, int colormap);
 void ApplyCustomColorMap(Mat src, Mat dst, Mat colormap);
 Mat GetPerspectiveTransform(PointVector src, PointVector dst);
@@ -109,6 +150,7 @@ void DrawContours(Mat src, PointsVector contours, int contourIdx, Scalar color,
 void Sobel(Mat src, Mat dst, int ddepth, int dx, int dy, int ksize, double scale, double delta, int borderType);
 void SpatialGradient(Mat src, Mat dx, Mat dy, int ksize, int borderType);
 void Remap(Mat src, Mat dst, Mat map1, Mat map2, int interpolation, int borderMode, Scalar borderValue);
+void ConvertMaps(Mat map1, Mat map2, Mat dstmap1, Mat dstmap2, int dstmap1type, bool nnInterpolation);
 void Filter2D(Mat src, Mat dst, int ddepth, Mat kernel, Point anchor, double delta, int borderType);
 void SepFilter2D(Mat src, Mat dst, int ddepth, Mat kernelX, Mat kernelY, Point anchor, double delta, int borderType);
 void LogPolar(Mat src, Mat dst, Point center, double m, int flags);
@@ -119,6 +161,14 @@ CLAHE CLAHE_Create();
 CLAHE CLAHE_CreateWithParams(double clipLimit, Size tileGridSize);
 void CLAHE_Close(CLAHE c);
 void CLAHE_Apply(CLAHE c, Mat src, Mat dst);
+LineSegmentDetector LineSegmentDetector_Create();
+LineSegmentDetector LineSegmentDetector_CreateWithParams(int refine, double scale, double sigmaScale, double quant,
+        double angTh, double logEps, double densityTh, int nBins);
+void LineSegmentDetector_Close(LineSegmentDetector lsd);
+void LineSegmentDetector_Detect(LineSegmentDetector lsd, Mat src, Mat lines);
+void LineSegmentDetector_DetectWithParams(LineSegmentDetector lsd, Mat src, Mat lines, Mat width, Mat prec, Mat nfa);
+void LineSegmentDetector_DrawSegments(LineSegmentDetector lsd, Mat img, Mat lines);
+int LineSegmentDetector_CompareSegments(LineSegmentDetector lsd, Size size, Mat lines1, Mat lines2, Mat img);
 void InvertAffineTransform(Mat src, Mat dst);
 Point2f PhaseCorrelate(Mat src1, Mat src2, Mat window, double* response);
 void Mat_Accumulate(Mat src, Mat dst);
@@ -103,6 +103,108 @@ func TestMinEnclosingCircle(t *testing.T) {
 	}
 }
 
+func TestMinEnclosingTriangle(t *testing.T) {
+	pts := []image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 0),
+		image.Pt(0, 10),
+		image.Pt(2, 2),
+	}
+	pv := NewPointVectorFromPoints(pts)
+	defer pv.Close()
+
+	triangle, area := MinEnclosingTriangle(pv)
+	if len(triangle) != 3 {
+		t.Fatalf("invalid MinEnclosingTriangle vertices: %v", triangle)
+	}
+	if math.Abs(area-50) > 0.1 {
+		t.Errorf("invalid MinEnclosingTriangle area: %v", area)
+	}
+}
+
+func TestPointPolygonTest(t *testing.T) {
+	square := NewPointVectorFromPoints([]image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 0),
+		image.Pt(10, 10),
+		image.Pt(0, 10),
+	})
+	defer square.Close()
+
+	if d := PointPolygonTest(square, Point2f{X: 5, Y: 3}, true); math.Abs(d-3) > 0.001 {
+		t.Errorf("invalid distance inside: %v", d)
+	}
+	if d := PointPolygonTest(square, Point2f{X: 15, Y: 5}, true); math.Abs(d+5) > 0.001 {
+		t.Errorf("invalid distance outside: %v", d)
+	}
+	if d := PointPolygonTest(square, Point2f{X: 10, Y: 5}, false); d != 0 {
+		t.Errorf("invalid result on edge: %v", d)
+	}
+	if d := PointPolygonTest(square, Point2f{X: -1, Y: 5}, false); d != -1 {
+		t.Errorf("invalid result outside: %v", d)
+	}
+
+	if !IsContourConvex(square) {
+		t.Error("square should be convex")
+	}
+
+	arrow := NewPointVectorFromPoints([]image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 5),
+		image.Pt(0, 10),
+		image.Pt(3, 5),
+	})
+	defer arrow.Close()
+	if IsContourConvex(arrow) {
+		t.Error("arrow should not be convex")
+	}
+}
+
+func TestIntersectConvexConvex(t *testing.T) {
+	p1 := NewPointVectorFromPoints([]image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 0),
+		image.Pt(10, 10),
+		image.Pt(0, 10),
+	})
+	defer p1.Close()
+	p2 := NewPointVectorFromPoints([]image.Point{
+		image.Pt(5, 5),
+		image.Pt(15, 5),
+		image.Pt(15, 15),
+		image.Pt(5, 15),
+	})
+	defer p2.Close()
+
+	area, intersection := IntersectConvexConvex(p1, p2, true)
+	if math.Abs(float64(area)-25) > 0.001 {
+		t.Errorf("invalid IntersectConvexConvex area: %v", area)
+	}
+	if len(intersection) != 4 {
+		t.Errorf("invalid IntersectConvexConvex intersection: %v", intersection)
+	}
+}
+
+func TestRotatedRectangleIntersection(t *testing.T) {
+	r1 := RotatedRect{Center: image.Pt(0, 0), Width: 10, Height: 10}
+	r2 := RotatedRect{Center: image.Pt(5, 0), Width: 10, Height: 10}
+	r3 := RotatedRect{Center: image.Pt(0, 0), Width: 4, Height: 4, Angle: 45}
+	r4 := RotatedRect{Center: image.Pt(50, 50), Width: 4, Height: 4}
+
+	kind, region := RotatedRectangleIntersection(r1, r2)
+	if kind != IntersectPartial || len(region) != 4 {
+		t.Errorf("invalid partial intersection: %v %v", kind, region)
+	}
+
+	if kind, _ := RotatedRectangleIntersection(r1, r3); kind != IntersectFull {
+		t.Errorf("invalid full intersection: %v", kind)
+	}
+
+	if kind, region := RotatedRectangleIntersection(r1, r4); kind != IntersectNone || len(region) != 0 {
+		t.Errorf("invalid no intersection: %v %v", kind, region)
+	}
+}
+
 func TestCvtColor(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadColor)
 	if img.Empty() {
@@ -119,6 +221,73 @@ func TestCvtColor(t *testing.T) {
 	}
 }
 
+func TestCvtColorTwoPlane(t *testing.T) {
+	const w, h = 8, 4
+	nv12 := make([]byte, w*h*3/2)
+	for i := range nv12 {
+		nv12[i] = byte(i * 7)
+	}
+
+	single, err := NewMatFromBytes(h*3/2, w, MatTypeCV8U, nv12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer single.Close()
+
+	want := NewMat()
+	defer want.Close()
+	CvtColor(single, &want, ColorYUVToBGRNV12)
+
+	y, uv, err := NewMatsFromTwoPlaneYUV(w, h, nv12[:w*h], w, nv12[w*h:], w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer y.Close()
+	defer uv.Close()
+
+	dst := NewMat()
+	defer dst.Close()
+	CvtColorTwoPlane(y, uv, &dst, ColorYUVToBGRNV12)
+
+	diff := NewMat()
+	defer diff.Close()
+	AbsDiff(want, dst, &diff)
+	gray := diff.Reshape(1, 0)
+	defer gray.Close()
+	if dst.Rows() != h || dst.Cols() != w || CountNonZero(gray) != 0 {
+		t.Error("Invalid CvtColorTwoPlane test")
+	}
+
+	if _, _, err := NewMatsFromTwoPlaneYUV(w-1, h, nv12[:w*h], w, nv12[w*h:], w); err == nil {
+		t.Error("NewMatsFromTwoPlaneYUV with an odd width should fail")
+	}
+}
+
+func TestNewMatsFromYUVPlanes(t *testing.T) {
+	const w, h, stride = 6, 4, 8
+	y := make([]byte, stride*h)
+	u := make([]byte, stride*h/2)
+	v := make([]byte, stride*h/2)
+	y[stride+5] = 10
+	u[stride+2] = 20
+	v[2] = 30
+
+	yMat, uMat, vMat, err := NewMatsFromYUVPlanes(w, h, image.YCbCrSubsampleRatio420, y, stride, u, v, stride)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer yMat.Close()
+	defer uMat.Close()
+	defer vMat.Close()
+
+	if uMat.Rows() != h/2 || uMat.Cols() != w/2 || vMat.Rows() != h/2 || vMat.Cols() != w/2 {
+		t.Errorf("Invalid NewMatsFromYUVPlanes chroma size: %vx%v", uMat.Rows(), uMat.Cols())
+	}
+	if yMat.GetUCharAt(1, 5) != 10 || uMat.GetUCharAt(1, 2) != 20 || vMat.GetUCharAt(0, 2) != 30 {
+		t.Error("Invalid NewMatsFromYUVPlanes values")
+	}
+}
+
 func TestBilateralFilter(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadColor)
 	if img.Empty() {
@@ -333,6 +502,44 @@ func TestPyrUp(t *testing.T) {
 	}
 }
 
+func TestBuildPyramid(t *testing.T) {
+	img := NewMatWithSize(64, 48, MatTypeCV8UC3)
+	defer img.Close()
+
+	pyramid := BuildPyramid(img, 3, BorderDefault)
+	defer func() {
+		for _, m := range pyramid {
+			m.Close()
+		}
+	}()
+
+	if len(pyramid) != 4 {
+		t.Fatalf("Invalid BuildPyramid test levels: %v", len(pyramid))
+	}
+	for i, m := range pyramid {
+		if m.Rows() != 64>>i || m.Cols() != 48>>i {
+			t.Errorf("Invalid BuildPyramid test level %v size: %vx%v", i, m.Rows(), m.Cols())
+		}
+	}
+}
+
+func TestPyrMeanShiftFiltering(t *testing.T) {
+	img := IMRead("images/face-detect.jpg", IMReadColor)
+	if img.Empty() {
+		t.Error("Invalid read of Mat in PyrMeanShiftFiltering test")
+	}
+	defer img.Close()
+
+	dest := NewMat()
+	defer dest.Close()
+
+	tc := NewTermCriteria(Count|EPS, 5, 1)
+	PyrMeanShiftFiltering(img, &dest, 10, 20, 1, tc)
+	if dest.Empty() || dest.Rows() != img.Rows() || dest.Cols() != img.Cols() || dest.Type() != img.Type() {
+		t.Error("Invalid PyrMeanShiftFiltering test")
+	}
+}
+
 func TestBoxPoints(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadGrayScale)
 	if img.Empty() {
@@ -374,6 +581,78 @@ func TestBoxPoints(t *testing.T) {
 	}
 }
 
+func TestHuMoments(t *testing.T) {
+	img := Zeros(100, 100, MatTypeCV8U)
+	defer img.Close()
+	rect := img.Region(image.Rect(20, 30, 60, 50))
+	rect.SetTo(NewScalar(255, 0, 0, 0))
+	rect.Close()
+
+	rotated := NewMat()
+	defer rotated.Close()
+	Rotate(img, &rotated, Rotate90Clockwise)
+
+	hu := HuMoments(Moments(img, true))
+	huRotated := HuMoments(Moments(rotated, true))
+	if hu[0] <= 0 {
+		t.Errorf("invalid first Hu moment: %v", hu[0])
+	}
+	for i := range hu {
+		if math.Abs(hu[i]-huRotated[i]) > 1e-6 {
+			t.Errorf("Hu moment %d should be invariant to rotation: %v %v", i, hu[i], huRotated[i])
+		}
+	}
+}
+
+func TestMatchShapes(t *testing.T) {
+	square := NewPointVectorFromPoints([]image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 0),
+		image.Pt(10, 10),
+		image.Pt(0, 10),
+	})
+	defer square.Close()
+	bigSquare := NewPointVectorFromPoints([]image.Point{
+		image.Pt(100, 100),
+		image.Pt(140, 100),
+		image.Pt(140, 140),
+		image.Pt(100, 140),
+	})
+	defer bigSquare.Close()
+	triangle := NewPointVectorFromPoints([]image.Point{
+		image.Pt(0, 0),
+		image.Pt(10, 0),
+		image.Pt(5, 30),
+	})
+	defer triangle.Close()
+
+	for _, method := range []ShapeMatchModes{ContoursMatchI1, ContoursMatchI2, ContoursMatchI3} {
+		same := MatchShapes(square, bigSquare, method, 0)
+		different := MatchShapes(square, triangle, method, 0)
+		if same > 0.001 {
+			t.Errorf("similar shapes should match with method %v: %v", method, same)
+		}
+		if different <= same {
+			t.Errorf("different shapes should not match with method %v: %v", method, different)
+		}
+	}
+
+	img1 := Zeros(50, 50, MatTypeCV8U)
+	defer img1.Close()
+	img2 := Zeros(50, 50, MatTypeCV8U)
+	defer img2.Close()
+	r1 := img1.Region(image.Rect(10, 10, 20, 20))
+	r1.SetTo(NewScalar(255, 0, 0, 0))
+	r1.Close()
+	r2 := img2.Region(image.Rect(20, 20, 40, 40))
+	r2.SetTo(NewScalar(255, 0, 0, 0))
+	r2.Close()
+
+	if d := MatchShapesImage(img1, img2, ContoursMatchI2, 0); d > 0.001 {
+		t.Errorf("similar images should match: %v", d)
+	}
+}
+
 func TestMinAreaRect(t *testing.T) {
 	src := []image.Point{
 		image.Pt(0, 2),
@@ -426,6 +705,88 @@ func TestFitEllipse(t *testing.T) {
 	}
 }
 
+func ellipseArc(cx, cy, a, b float64, from, to float64, n int) []Point2f {
+	pts := make([]Point2f, n)
+	for i := range pts {
+		theta := from + (to-from)*float64(i)/float64(n-1)
+		pts[i] = Point2f{X: float32(cx + a*math.Cos(theta)), Y: float32(cy + b*math.Sin(theta))}
+	}
+	return pts
+}
+
+func TestFitEllipseAMSAndDirect(t *testing.T) {
+	arc := ellipseArc(50, 40, 30, 20, 0, math.Pi, 20)
+	src := make([]image.Point, len(arc))
+	for i, pt := range arc {
+		src[i] = image.Pt(int(math.Round(float64(pt.X))), int(math.Round(float64(pt.Y))))
+	}
+
+	pv := NewPointVectorFromPoints(src)
+	defer pv.Close()
+
+	tests := []struct {
+		name string
+		rect RotatedRect
+	}{
+		{"FitEllipseAMS", FitEllipseAMS(pv)},
+		{"FitEllipseDirect", FitEllipseDirect(pv)},
+	}
+	for _, tt := range tests {
+		if absInt(tt.rect.Center.X-50) > 1 || absInt(tt.rect.Center.Y-40) > 1 {
+			t.Errorf("%s: unexpected center = %v, want = %v", tt.name, tt.rect.Center, image.Pt(50, 40))
+		}
+		if len(tt.rect.Points) != 4 {
+			t.Errorf("%s: unexpected number of points = %v, want = 4", tt.name, len(tt.rect.Points))
+		}
+		major, minor := tt.rect.Width, tt.rect.Height
+		if major < minor {
+			major, minor = minor, major
+		}
+		if absInt(major-60) > 2 || absInt(minor-40) > 2 {
+			t.Errorf("%s: unexpected size = %vx%v, want = 60x40", tt.name, major, minor)
+		}
+	}
+}
+
+func TestFitEllipse2f(t *testing.T) {
+	arc := ellipseArc(50.25, 40.5, 30, 20, 0, math.Pi, 20)
+	pv := NewPoint2fVectorFromPoints(arc)
+	defer pv.Close()
+
+	tests := []struct {
+		name string
+		rect RotatedRect2f
+	}{
+		{"FitEllipse2f", FitEllipse2f(pv)},
+		{"FitEllipseAMS2f", FitEllipseAMS2f(pv)},
+		{"FitEllipseDirect2f", FitEllipseDirect2f(pv)},
+	}
+	for _, tt := range tests {
+		// the points lie exactly on the ellipse, so the fit keeps the
+		// subpixel center and size.
+		if math.Abs(float64(tt.rect.Center.X)-50.25) > 0.05 || math.Abs(float64(tt.rect.Center.Y)-40.5) > 0.05 {
+			t.Errorf("%s: unexpected center = %v, want = {50.25 40.5}", tt.name, tt.rect.Center)
+		}
+		if len(tt.rect.Points) != 4 {
+			t.Errorf("%s: unexpected number of points = %v, want = 4", tt.name, len(tt.rect.Points))
+		}
+		major, minor := tt.rect.Width, tt.rect.Height
+		if major < minor {
+			major, minor = minor, major
+		}
+		if math.Abs(float64(major)-60) > 0.1 || math.Abs(float64(minor)-40) > 0.1 {
+			t.Errorf("%s: unexpected size = %vx%v, want = 60x40", tt.name, major, minor)
+		}
+	}
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
 func TestFindContours(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadGrayScale)
 	if img.Empty() {
@@ -689,6 +1050,36 @@ func TestGetGaussianKernelWithParams(t *testing.T) {
 
 }
 
+func TestGetGaborKernel(t *testing.T) {
+	kernel := GetGaborKernel(image.Pt(21, 11), 4, 0, 10, 0.5, 0, MatTypeCV32F)
+	defer kernel.Close()
+	if kernel.Rows() != 11 || kernel.Cols() != 21 || kernel.Type() != MatTypeCV32F {
+		t.Errorf("Invalid GetGaborKernel test: %vx%v %v", kernel.Rows(), kernel.Cols(), kernel.Type())
+	}
+	if v := kernel.GetFloatAt(5, 10); v != 1 {
+		t.Errorf("Invalid GetGaborKernel test center value: %v", v)
+	}
+}
+
+func TestGetDerivKernels(t *testing.T) {
+	kx := NewMat()
+	defer kx.Close()
+	ky := NewMat()
+	defer ky.Close()
+
+	GetDerivKernels(&kx, &ky, 1, 0, 3, false, MatTypeCV32F)
+	if kx.Total() != 3 || ky.Total() != 3 {
+		t.Fatalf("Invalid GetDerivKernels test sizes: %v %v", kx.Total(), ky.Total())
+	}
+
+	want := []float32{-1, 0, 1}
+	for i, v := range want {
+		if kx.GetFloatAt(i, 0) != v {
+			t.Errorf("Invalid GetDerivKernels test kx[%v]: %v, want %v", i, kx.GetFloatAt(i, 0), v)
+		}
+	}
+}
+
 func TestLaplacian(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadColor)
 	if img.Empty() {
@@ -794,6 +1185,45 @@ func TestGoodFeaturesToTrackAndCornerSubPix(t *testing.T) {
 	}
 }
 
+func TestCornerResponses(t *testing.T) {
+	img := NewMatWithSize(40, 40, MatTypeCV8U)
+	defer img.Close()
+	square := img.Region(image.Rect(10, 10, 30, 30))
+	square.SetTo(NewScalar(255, 0, 0, 0))
+	square.Close()
+
+	dst := NewMat()
+	defer dst.Close()
+
+	CornerHarris(img, &dst, 3, 3, 0.04, BorderDefault)
+	if dst.Rows() != 40 || dst.Cols() != 40 || dst.Type() != MatTypeCV32F {
+		t.Fatalf("Invalid CornerHarris test size or type: %vx%v %v", dst.Rows(), dst.Cols(), dst.Type())
+	}
+	_, maxVal, _, maxLoc := MinMaxLoc(dst)
+	if maxVal <= 0 {
+		t.Errorf("Invalid CornerHarris test max response: %v", maxVal)
+	}
+	nearEdge := func(v int) bool { return absInt(v-10) <= 2 || absInt(v-30) <= 2 }
+	if !nearEdge(maxLoc.X) || !nearEdge(maxLoc.Y) {
+		t.Errorf("Invalid CornerHarris test max location: %v", maxLoc)
+	}
+
+	CornerMinEigenVal(img, &dst, 3, 3, BorderDefault)
+	if dst.Rows() != 40 || dst.Cols() != 40 || dst.Type() != MatTypeCV32F {
+		t.Errorf("Invalid CornerMinEigenVal test size or type: %vx%v %v", dst.Rows(), dst.Cols(), dst.Type())
+	}
+
+	CornerEigenValsAndVecs(img, &dst, 3, 3, BorderDefault)
+	if dst.Rows() != 40 || dst.Cols() != 40 || dst.Channels() != 6 {
+		t.Errorf("Invalid CornerEigenValsAndVecs test size or channels: %vx%v %v", dst.Rows(), dst.Cols(), dst.Channels())
+	}
+
+	PreCornerDetect(img, &dst, 3, BorderDefault)
+	if dst.Rows() != 40 || dst.Cols() != 40 || dst.Type() != MatTypeCV32F {
+		t.Errorf("Invalid PreCornerDetect test size or type: %vx%v %v", dst.Rows(), dst.Cols(), dst.Type())
+	}
+}
+
 func TestGrabCut(t *testing.T) {
 	img := IMRead("images/face-detect.jpg", IMReadGrayScale)
 	if img.Empty() {
@@ -1171,6 +1601,75 @@ func TestCompareHist(t *testing.T) {
 
 }
 
+func TestEMD(t *testing.T) {
+	sig1 := NewMatWithSize(2, 2, MatTypeCV32F)
+	defer sig1.Close()
+	sig1.SetFloatAt(0, 0, 1)
+	sig1.SetFloatAt(0, 1, 0)
+	sig1.SetFloatAt(1, 0, 1)
+	sig1.SetFloatAt(1, 1, 1)
+
+	sig2 := NewMatWithSize(2, 2, MatTypeCV32F)
+	defer sig2.Close()
+	sig2.SetFloatAt(0, 0, 1)
+	sig2.SetFloatAt(0, 1, 2)
+	sig2.SetFloatAt(1, 0, 1)
+	sig2.SetFloatAt(1, 1, 3)
+
+	if dist := EMD(sig1, sig1, DistL1); dist != 0 {
+		t.Errorf("Invalid EMD test with identical signatures: %v", dist)
+	}
+	if dist := EMD(sig1, sig2, DistL1); math.Abs(float64(dist)-2) > 1e-5 {
+		t.Errorf("Invalid EMD test: %v, want 2", dist)
+	}
+
+	cost := NewMat()
+	defer cost.Close()
+	flow := NewMat()
+	defer flow.Close()
+
+	lowerBound := float32(100)
+	dist := EMDWithParams(sig1, sig2, DistL2, cost, &lowerBound, &flow)
+	if math.Abs(float64(dist)-2) > 1e-5 || math.Abs(float64(lowerBound)-2) > 1e-5 {
+		t.Errorf("Invalid EMDWithParams test: %v, lower bound %v, want 2", dist, lowerBound)
+	}
+	if flow.Rows() != 2 || flow.Cols() != 2 {
+		t.Errorf("Invalid EMDWithParams flow size: %vx%v", flow.Rows(), flow.Cols())
+	}
+
+	weights := NewMatWithSize(2, 1, MatTypeCV32F)
+	defer weights.Close()
+	weights.SetFloatAt(0, 0, 1)
+	weights.SetFloatAt(1, 0, 1)
+
+	userCost := NewMatWithSize(2, 2, MatTypeCV32F)
+	defer userCost.Close()
+	userCost.SetFloatAt(0, 1, 3)
+	userCost.SetFloatAt(1, 0, 3)
+
+	if dist := EMDWithParams(weights, weights, DistUser, userCost, nil, nil); dist != 0 {
+		t.Errorf("Invalid EMDWithParams test with a cost matrix: %v", dist)
+	}
+
+	// all the weight has to move from the first point to the second one, so
+	// the distance is the cost between them.
+	from := NewMatWithSize(2, 1, MatTypeCV32F)
+	defer from.Close()
+	from.SetFloatAt(0, 0, 1)
+	to := NewMatWithSize(2, 1, MatTypeCV32F)
+	defer to.Close()
+	to.SetFloatAt(1, 0, 1)
+
+	if dist := EMDWithParams(from, to, DistUser, userCost, nil, nil); math.Abs(float64(dist)-3) > 1e-5 {
+		t.Errorf("Invalid EMDWithParams test with a cost matrix: %v, want 3", dist)
+	}
+
+	userCost.SetFloatAt(0, 1, 5)
+	if dist := EMDWithParams(from, to, DistUser, userCost, nil, nil); math.Abs(float64(dist)-5) > 1e-5 {
+		t.Errorf("Invalid EMDWithParams test with an updated cost matrix: %v, want 5", dist)
+	}
+}
+
 func TestDrawing(t *testing.T) {
 	img := NewMatWithSize(150, 150, MatTypeCV8U)
 	if img.Empty() {
@@ -1188,6 +1687,42 @@ func TestDrawing(t *testing.T) {
 	}
 }
 
+func TestDrawingWithParams(t *testing.T) {
+	img := NewMatWithSize(150, 150, MatTypeCV8U)
+	if img.Empty() {
+		t.Error("Invalid Mat in DrawingWithParams")
+	}
+	defer img.Close()
+
+	white := color.RGBA{255, 255, 255, 0}
+	ArrowedLineWithParams(&img, image.Pt(50, 50), image.Pt(75, 75), white, 3, LineAA, 0, 0.2)
+	CircleWithParams(&img, image.Pt(60, 60), 20, white, 3, LineAA, 0)
+	EllipseWithParams(&img, image.Pt(60, 60), image.Pt(30, 20), 0, 0, 360, white, 1, LineAA, 0)
+	RectangleWithParams(&img, image.Rect(50, 50, 75, 75), white, 3, LineAA, 0)
+	LineWithParams(&img, image.Pt(0, 140), image.Pt(140, 140), white, 1, Line8, 0)
+
+	if v := img.GetUCharAt(140, 70); v != 255 {
+		t.Errorf("TestDrawingWithParams(): wrong pixel value = %v, want = %v", v, 255)
+	}
+}
+
+func TestDrawMarker(t *testing.T) {
+	img := NewMatWithSize(100, 100, MatTypeCV8UC1)
+	defer img.Close()
+
+	DrawMarker(&img, image.Pt(50, 50), color.RGBA{255, 255, 255, 0}, MarkerCross, 20, 1, Line8)
+
+	if v := img.GetUCharAt(50, 50); v != 255 {
+		t.Errorf("TestDrawMarker(): wrong pixel value = %v, want = %v", v, 255)
+	}
+	if v := img.GetUCharAt(50, 58); v != 255 {
+		t.Errorf("TestDrawMarker(): wrong pixel value = %v, want = %v", v, 255)
+	}
+	if v := img.GetUCharAt(10, 10); v != 0 {
+		t.Errorf("TestDrawMarker(): wrong pixel value = %v, want = %v", v, 0)
+	}
+}
+
 func TestGetTextSize(t *testing.T) {
 	size := GetTextSize("test", FontHersheySimplex, 1.2, 1)
 	if size.X != 72 {
@@ -1242,6 +1777,17 @@ func TestPutTextWithParams(t *testing.T) {
 	}
 }
 
+func TestGetFontScaleFromHeight(t *testing.T) {
+	scale := GetFontScaleFromHeight(FontHersheySimplex, 30, 1)
+	if scale <= 0 {
+		t.Errorf("TestGetFontScaleFromHeight(): invalid scale %v", scale)
+	}
+
+	if larger := GetFontScaleFromHeight(FontHersheySimplex, 60, 1); larger <= scale {
+		t.Errorf("TestGetFontScaleFromHeight(): expected scale for 60px (%v) to be larger than for 30px (%v)", larger, scale)
+	}
+}
+
 func TestResize(t *testing.T) {
 	src := IMRead("images/gocvlogo.jpg", IMReadColor)
 	if src.Empty() {
@@ -1422,6 +1968,53 @@ func TestWatershed(t *testing.T) {
 	}
 }
 
+func TestFloodFill(t *testing.T) {
+	img := NewMatWithSizeFromScalar(NewScalar(0, 0, 0, 0), 20, 20, MatTypeCV8UC3)
+	defer img.Close()
+	square := img.Region(image.Rect(5, 5, 15, 15))
+	square.SetTo(NewScalar(255, 255, 255, 0))
+	square.Close()
+
+	area, rect := FloodFill(&img, image.Pt(10, 10), NewScalar(0, 0, 255, 0),
+		NewScalar(0, 0, 0, 0), NewScalar(0, 0, 0, 0), 4, 0)
+	if area != 100 {
+		t.Errorf("invalid FloodFill area: %v", area)
+	}
+	if rect != image.Rect(5, 5, 15, 15) {
+		t.Errorf("invalid FloodFill rect: %v", rect)
+	}
+	if v := img.GetVecbAt(10, 10); v[0] != 0 || v[1] != 0 || v[2] != 255 {
+		t.Errorf("invalid FloodFill color: %v", v)
+	}
+	if v := img.GetVecbAt(0, 0); v[2] != 0 {
+		t.Errorf("FloodFill should not fill outside the component: %v", v)
+	}
+}
+
+func TestFloodFillWithMask(t *testing.T) {
+	img := NewMatWithSizeFromScalar(NewScalar(100, 0, 0, 0), 10, 10, MatTypeCV8U)
+	defer img.Close()
+	img.SetUCharAt(0, 0, 110)
+
+	mask := Zeros(12, 12, MatTypeCV8U)
+	defer mask.Close()
+
+	area, rect := FloodFillWithMask(&img, &mask, image.Pt(5, 5), NewScalar(0, 0, 0, 0),
+		NewScalar(5, 0, 0, 0), NewScalar(5, 0, 0, 0), 8, 255, FloodFillFixedRange|FloodFillMaskOnly)
+	if area != 99 {
+		t.Errorf("invalid FloodFillWithMask area: %v", area)
+	}
+	if rect != image.Rect(0, 0, 10, 10) {
+		t.Errorf("invalid FloodFillWithMask rect: %v", rect)
+	}
+	if img.GetUCharAt(5, 5) != 100 {
+		t.Errorf("FloodFillMaskOnly should not change the image: %v", img.GetUCharAt(5, 5))
+	}
+	if mask.GetUCharAt(6, 6) != 255 || mask.GetUCharAt(1, 1) != 0 {
+		t.Errorf("invalid FloodFillWithMask mask: %v %v", mask.GetUCharAt(6, 6), mask.GetUCharAt(1, 1))
+	}
+}
+
 func TestApplyColorMap(t *testing.T) {
 	type args struct {
 		colormapType ColormapTypes
@@ -1777,6 +2370,62 @@ func TestPolylines(t *testing.T) {
 	}
 }
 
+func TestFillPolyWithParams(t *testing.T) {
+	img := NewMatWithSize(100, 100, MatTypeCV8UC1)
+	defer img.Close()
+
+	white := color.RGBA{255, 255, 255, 0}
+	pts := [][]image.Point{
+		{
+			image.Pt(10, 10),
+			image.Pt(10, 20),
+			image.Pt(20, 20),
+			image.Pt(20, 10),
+		},
+	}
+	pv := NewPointsVectorFromPoints(pts)
+	defer pv.Close()
+
+	FillPolyWithParams(&img, pv, white, Line4, 0, image.Pt(30, 30))
+
+	if v := img.GetUCharAt(45, 45); v != 255 {
+		t.Errorf("TestFillPolyWithParams(): wrong pixel value = %v, want = %v", v, 255)
+	}
+	if v := img.GetUCharAt(15, 15); v != 0 {
+		t.Errorf("TestFillPolyWithParams(): wrong pixel value = %v, want = %v", v, 0)
+	}
+
+	PolylinesWithParams(&img, pv, true, white, 1, Line8, 0)
+
+	if v := img.GetUCharAt(10, 10); v != 255 {
+		t.Errorf("TestFillPolyWithParams(): wrong pixel value = %v, want = %v", v, 255)
+	}
+}
+
+func TestEllipse2PolyAndFillConvexPoly(t *testing.T) {
+	pv := Ellipse2Poly(image.Pt(50, 50), image.Pt(30, 20), 0, 0, 360, 10)
+	defer pv.Close()
+
+	if pv.Size() < 36 {
+		t.Errorf("TestEllipse2Poly(): expected at least 36 points, got %d", pv.Size())
+	}
+	if pt := pv.At(0); pt != image.Pt(80, 50) {
+		t.Errorf("TestEllipse2Poly(): wrong first point = %v, want = %v", pt, image.Pt(80, 50))
+	}
+
+	img := NewMatWithSize(100, 100, MatTypeCV8UC1)
+	defer img.Close()
+
+	FillConvexPoly(&img, pv, color.RGBA{255, 255, 255, 0}, Line8, 0)
+
+	if v := img.GetUCharAt(50, 50); v != 255 {
+		t.Errorf("TestFillConvexPoly(): wrong pixel value = %v, want = %v", v, 255)
+	}
+	if v := img.GetUCharAt(5, 5); v != 0 {
+		t.Errorf("TestFillConvexPoly(): wrong pixel value = %v, want = %v", v, 0)
+	}
+}
+
 func TestRemap(t *testing.T) {
 	src := IMRead("images/gocvlogo.jpg", IMReadUnchanged)
 	defer src.Close()
@@ -1797,6 +2446,70 @@ func TestRemap(t *testing.T) {
 	}
 }
 
+func identityMaps(rows, cols int) (Mat, Mat) {
+	mapX := NewMatWithSize(rows, cols, MatTypeCV32F)
+	mapY := NewMatWithSize(rows, cols, MatTypeCV32F)
+	for y := 0; y < rows; y++ {
+		for x := 0; x < cols; x++ {
+			mapX.SetFloatAt(y, x, float32(x))
+			mapY.SetFloatAt(y, x, float32(y))
+		}
+	}
+	return mapX, mapY
+}
+
+func TestConvertMaps(t *testing.T) {
+	mapX, mapY := identityMaps(20, 30)
+	defer mapX.Close()
+	defer mapY.Close()
+
+	dst1 := NewMat()
+	defer dst1.Close()
+	dst2 := NewMat()
+	defer dst2.Close()
+
+	ConvertMaps(mapX, mapY, &dst1, &dst2, MatTypeCV16SC2, false)
+	if dst1.Type() != MatTypeCV16SC2 || dst1.Rows() != 20 || dst1.Cols() != 30 {
+		t.Errorf("Invalid ConvertMaps test dstmap1: %vx%v %v", dst1.Rows(), dst1.Cols(), dst1.Type())
+	}
+	if dst2.Type() != MatTypeCV16U || dst2.Rows() != 20 || dst2.Cols() != 30 {
+		t.Errorf("Invalid ConvertMaps test dstmap2: %vx%v %v", dst2.Rows(), dst2.Cols(), dst2.Type())
+	}
+
+	ConvertMaps(mapX, mapY, &dst1, &dst2, MatTypeCV16SC2, true)
+	if !dst2.Empty() {
+		t.Error("Invalid ConvertMaps test: dstmap2 should be empty with nnInterpolation")
+	}
+}
+
+func TestRemapper(t *testing.T) {
+	src := IMRead("images/gocvlogo.jpg", IMReadGrayScale)
+	if src.Empty() {
+		t.Fatal("Invalid read of Mat in Remapper test")
+	}
+	defer src.Close()
+
+	mapX, mapY := identityMaps(src.Rows(), src.Cols())
+	defer mapX.Close()
+	defer mapY.Close()
+
+	r := NewRemapper(mapX, mapY, InterpolationLinear, BorderConstant, color.RGBA{0, 0, 0, 0})
+	defer r.Close()
+
+	dst := NewMat()
+	defer dst.Close()
+	diff := NewMat()
+	defer diff.Close()
+
+	for i := 0; i < 2; i++ {
+		r.Apply(src, &dst)
+		AbsDiff(src, dst, &diff)
+		if n := CountNonZero(diff); n != 0 {
+			t.Errorf("Invalid Remapper test: %v pixels differ", n)
+		}
+	}
+}
+
 func TestFilter2D(t *testing.T) {
 	src := IMRead("images/gocvlogo.jpg", IMReadUnchanged)
 	defer src.Close()
@@ -1934,6 +2647,68 @@ func TestCLAHEWithParams(t *testing.T) {
 	}
 }
 
+func TestLineSegmentDetector(t *testing.T) {
+	img := NewMatWithSize(100, 100, MatTypeCV8U)
+	defer img.Close()
+	Line(&img, image.Pt(10, 50), image.Pt(90, 50), color.RGBA{255, 255, 255, 0}, 3)
+
+	lsd := NewLineSegmentDetector()
+	defer lsd.Close()
+
+	lines := NewMat()
+	defer lines.Close()
+
+	lsd.Detect(img, &lines)
+	if lines.Empty() || lines.Type() != MatTypeCV32FC4 {
+		t.Fatalf("Invalid LineSegmentDetector Detect test: %v %v", lines.Total(), lines.Type())
+	}
+
+	drawn := NewMatWithSize(100, 100, MatTypeCV8UC3)
+	defer drawn.Close()
+	lsd.DrawSegments(&drawn, lines)
+	gray := drawn.Reshape(1, 0)
+	defer gray.Close()
+	if CountNonZero(gray) == 0 {
+		t.Error("Invalid LineSegmentDetector DrawSegments test")
+	}
+
+	cmp := NewMat()
+	defer cmp.Close()
+	if n := lsd.CompareSegments(image.Pt(100, 100), lines, lines, &cmp); n != 0 {
+		t.Errorf("Invalid LineSegmentDetector CompareSegments test: %v", n)
+	}
+	if cmp.Rows() != 100 || cmp.Cols() != 100 {
+		t.Errorf("Invalid LineSegmentDetector CompareSegments image size: %vx%v", cmp.Rows(), cmp.Cols())
+	}
+}
+
+func TestLineSegmentDetectorWithParams(t *testing.T) {
+	img := NewMatWithSize(100, 100, MatTypeCV8U)
+	defer img.Close()
+	Line(&img, image.Pt(50, 10), image.Pt(50, 90), color.RGBA{255, 255, 255, 0}, 3)
+
+	lsd := NewLineSegmentDetectorWithParams(LsdRefineAdv, 0.8, 0.6, 2.0, 22.5, 0, 0.7, 1024)
+	defer lsd.Close()
+
+	lines := NewMat()
+	defer lines.Close()
+	width := NewMat()
+	defer width.Close()
+	prec := NewMat()
+	defer prec.Close()
+	nfa := NewMat()
+	defer nfa.Close()
+
+	lsd.DetectWithParams(img, &lines, &width, &prec, &nfa)
+	if lines.Empty() {
+		t.Fatal("Invalid LineSegmentDetector DetectWithParams test")
+	}
+	if width.Total() != lines.Total() || prec.Total() != lines.Total() || nfa.Total() != lines.Total() {
+		t.Errorf("Invalid LineSegmentDetector DetectWithParams test: %v %v %v %v",
+			lines.Total(), width.Total(), prec.Total(), nfa.Total())
+	}
+}
+
 func TestPhaseCorrelate(t *testing.T) {
 	template := IMRead("images/simple.jpg", IMReadGrayScale)
 	matched := IMRead("images/simple-translated.jpg", IMReadGrayScale)
@@ -2146,6 +2921,70 @@ func TestMatToImageYUVWithParams(t *testing.T) {
 	}
 }
 
+func TestImageYCbCrToMat(t *testing.T) {
+	ratios := []image.YCbCrSubsampleRatio{
+		image.YCbCrSubsampleRatio444,
+		image.YCbCrSubsampleRatio422,
+		image.YCbCrSubsampleRatio420,
+		image.YCbCrSubsampleRatio440,
+		image.YCbCrSubsampleRatio411,
+		image.YCbCrSubsampleRatio410,
+	}
+	for _, ratio := range ratios {
+		img := image.NewYCbCr(image.Rect(0, 0, 21, 13), ratio)
+		for i := range img.Y {
+			img.Y[i] = byte(i)
+		}
+		for i := range img.Cb {
+			img.Cb[i] = byte(64 + i%128)
+			img.Cr[i] = byte(192 - i%128)
+		}
+
+		for _, sub := range []*image.YCbCr{img, img.SubImage(image.Rect(3, 1, 20, 12)).(*image.YCbCr)} {
+			mat, err := ImageYCbCrToMat(sub)
+			if err != nil {
+				t.Fatalf("ImageYCbCrToMat %v: %v", ratio, err)
+			}
+
+			b := sub.Bounds()
+			if mat.Rows() != b.Dy() || mat.Cols() != b.Dx() || mat.Type() != MatTypeCV8UC3 {
+				t.Errorf("ImageYCbCrToMat %v: invalid Mat %vx%v %v", ratio, mat.Rows(), mat.Cols(), mat.Type())
+				mat.Close()
+				continue
+			}
+
+			for y := b.Min.Y; y < b.Max.Y; y++ {
+				for x := b.Min.X; x < b.Max.X; x++ {
+					c := sub.YCbCrAt(x, y)
+					r, g, bl := color.YCbCrToRGB(c.Y, c.Cb, c.Cr)
+					v := mat.GetVecbAt(y-b.Min.Y, x-b.Min.X)
+					if absInt(int(v[0])-int(bl)) > 2 || absInt(int(v[1])-int(g)) > 2 || absInt(int(v[2])-int(r)) > 2 {
+						t.Fatalf("ImageYCbCrToMat %v: pixel (%v, %v) is %v, want BGR %v %v %v", ratio, x, y, v, bl, g, r)
+					}
+				}
+			}
+			mat.Close()
+		}
+	}
+}
+
+func TestMatToImageYUVValues(t *testing.T) {
+	mat := NewMatWithSizeFromScalar(NewScalar(200, 100, 50, 0), 9, 7, MatTypeCV8UC3)
+	defer mat.Close()
+
+	wy, wcb, wcr := color.RGBToYCbCr(50, 100, 200)
+	for _, ratio := range []image.YCbCrSubsampleRatio{image.YCbCrSubsampleRatio444, image.YCbCrSubsampleRatio420, image.YCbCrSubsampleRatio410} {
+		img, err := mat.ToImageYUVWithParams(ratio)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := img.YCbCrAt(6, 8)
+		if absInt(int(c.Y)-int(wy)) > 1 || absInt(int(c.Cb)-int(wcb)) > 1 || absInt(int(c.Cr)-int(wcr)) > 1 {
+			t.Errorf("ToImageYUVWithParams %v: got %v, want %v %v %v", ratio, c, wy, wcb, wcr)
+		}
+	}
+}
+
 //Tests that image is the same after converting to Mat and back to Image
 func TestImageToMatRGBA(t *testing.T) {
 	file, err := os.Open("images/gocvlogo.png")
@@ -8,18 +8,14 @@ package gocv
 */
 import "C"
 
-// addMatToProfile does nothing if matprofile tag is not set.
-func addMatToProfile(p C.Mat) {
-	return
-}
-
 // newMat returns a new Mat from a C Mat
 func newMat(p C.Mat) Mat {
-	return Mat{p: p}
+	return withFinalizer(Mat{p: p})
 }
 
 // Close the Mat object.
 func (m *Mat) Close() error {
+	releaseMat(m)
 	C.Mat_Close(m.p)
 	m.p = nil
 	m.d = nil
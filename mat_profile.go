@@ -47,6 +47,10 @@ import (
 // or tests using the following build tag:
 // -tags matprofile
 //
+// Mats that were collected by the garbage collector without being closed,
+// when enabled with SetMatFinalizers, are kept in this profile and counted
+// by LeakedMatCount.
+//
 // For more information, see the runtime/pprof package documentation.
 var MatProfile *pprof.Profile
 
@@ -58,21 +62,16 @@ func init() {
 	}
 }
 
-// addMatToProfile records Mat to the MatProfile.
-func addMatToProfile(p C.Mat) {
-	MatProfile.Add(p, 1)
-	return
-}
-
 // newMat returns a new Mat from a C Mat and records it to the MatProfile.
 func newMat(p C.Mat) Mat {
-	m := Mat{p: p}
+	m := withFinalizer(Mat{p: p})
 	MatProfile.Add(p, 1)
 	return m
 }
 
 // Close the Mat object.
 func (m *Mat) Close() error {
+	releaseMat(m)
 	C.Mat_Close(m.p)
 	MatProfile.Remove(m.p)
 	m.p = nil
@@ -0,0 +1,192 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "core.h"
+*/
+import "C"
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Scope tracks Mats so that they can all be closed at once, typically
+// at the end of a function or of the processing of a frame:
+//
+//	scope := gocv.NewScope()
+//	defer scope.Close()
+//
+//	gray := scope.NewMat()
+//	gocv.CvtColor(img, &gray, gocv.ColorBGRToGray)
+//	channels := scope.AddMats(gocv.Split(img))
+//
+// A Mat tracked by a Scope may still be closed directly, in which case it is
+// no longer tracked. A Mat belongs to at most one Scope.
+type Scope struct {
+	mats map[C.Mat]Mat
+}
+
+// scopes guards all the Scopes, and maps each tracked Mat to its Scope so
+// that closing a Mat directly removes it from its Scope.
+var scopes = struct {
+	sync.Mutex
+	count int64
+	mats  map[C.Mat]*Scope
+}{mats: make(map[C.Mat]*Scope)}
+
+// NewScope returns a new empty Scope.
+func NewScope() *Scope {
+	return &Scope{mats: make(map[C.Mat]Mat)}
+}
+
+// Add tracks m in the Scope and returns it, so that it can wrap the creation
+// of a Mat:
+//
+//	dst := scope.Add(gocv.NewMatWithSize(rows, cols, gocv.MatTypeCV8U))
+//
+// If m is already tracked by another Scope, it is moved to this one.
+func (s *Scope) Add(m Mat) Mat {
+	if m.p == nil {
+		return m
+	}
+
+	scopes.Lock()
+	defer scopes.Unlock()
+
+	if prev, ok := scopes.mats[m.p]; ok {
+		delete(prev.mats, m.p)
+	} else {
+		atomic.AddInt64(&scopes.count, 1)
+	}
+	scopes.mats[m.p] = s
+	s.mats[m.p] = m
+
+	return m
+}
+
+// AddMats tracks all of mats in the Scope and returns them.
+func (s *Scope) AddMats(mats []Mat) []Mat {
+	for _, m := range mats {
+		s.Add(m)
+	}
+	return mats
+}
+
+// NewMat returns a new empty Mat tracked by the Scope.
+func (s *Scope) NewMat() Mat {
+	return s.Add(NewMat())
+}
+
+// NewMatWithSize returns a new Mat with a specific size and type tracked by
+// the Scope.
+func (s *Scope) NewMatWithSize(rows int, cols int, mt MatType) Mat {
+	return s.Add(NewMatWithSize(rows, cols, mt))
+}
+
+// Len returns the number of Mats tracked by the Scope.
+func (s *Scope) Len() int {
+	scopes.Lock()
+	defer scopes.Unlock()
+
+	return len(s.mats)
+}
+
+// Close closes all the Mats tracked by the Scope. The Scope is empty
+// afterwards and can be reused.
+func (s *Scope) Close() error {
+	scopes.Lock()
+	mats := s.mats
+	s.mats = make(map[C.Mat]Mat)
+	for p := range mats {
+		delete(scopes.mats, p)
+	}
+	atomic.AddInt64(&scopes.count, -int64(len(mats)))
+	scopes.Unlock()
+
+	for _, m := range mats {
+		m.Close()
+	}
+	return nil
+}
+
+// untrackMat removes the Mat p from its Scope, if any.
+func untrackMat(p C.Mat) {
+	if atomic.LoadInt64(&scopes.count) == 0 {
+		return
+	}
+
+	scopes.Lock()
+	defer scopes.Unlock()
+
+	if s, ok := scopes.mats[p]; ok {
+		delete(s.mats, p)
+		delete(scopes.mats, p)
+		atomic.AddInt64(&scopes.count, -1)
+	}
+}
+
+// matRef is the object a finalizer is attached to when Mat finalizers are
+// enabled. It is shared by all the copies of a Mat, so that the finalizer
+// only runs once none of them is reachable anymore.
+type matRef struct {
+	p C.Mat
+}
+
+// matFinalizers is non-zero when Mat finalizers are enabled.
+var matFinalizers int32
+
+// leakedMats counts the Mats that were collected without Close.
+var leakedMats int64
+
+// SetMatFinalizers enables or disables a runtime finalizer safety net for
+// the Mats created afterwards. When enabled, a Mat that becomes unreachable
+// without having been closed is reported as leaked: it is counted by
+// LeakedMatCount and, when built with the matprofile tag, its entry stays in
+// the MatProfile, to report where it was created.
+//
+// The finalizer never closes the Mat: OpenCV may still be using it after
+// its last use in Go, for example during the cgo call that received it.
+// Mats must still be closed explicitly, either directly or with a Scope.
+func SetMatFinalizers(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&matFinalizers, 1)
+	} else {
+		atomic.StoreInt32(&matFinalizers, 0)
+	}
+}
+
+// LeakedMatCount returns the number of Mats that were collected by the
+// garbage collector without having been closed, among those created while
+// Mat finalizers were enabled with SetMatFinalizers. In a program that is not
+// leaking, it stays at zero.
+func LeakedMatCount() int64 {
+	return atomic.LoadInt64(&leakedMats)
+}
+
+// withFinalizer attaches a finalizer to m if Mat finalizers are enabled.
+func withFinalizer(m Mat) Mat {
+	if atomic.LoadInt32(&matFinalizers) == 0 || m.p == nil {
+		return m
+	}
+
+	m.r = &matRef{p: m.p}
+	runtime.SetFinalizer(m.r, finalizeMat)
+	return m
+}
+
+// finalizeMat reports a Mat that was collected without Close. It does not
+// close it, since it may still be in use by OpenCV, which also keeps its
+// entry in the MatProfile.
+func finalizeMat(r *matRef) {
+	atomic.AddInt64(&leakedMats, 1)
+}
+
+// releaseMat stops tracking the Mat m before it is closed.
+func releaseMat(m *Mat) {
+	if m.r != nil {
+		runtime.SetFinalizer(m.r, nil)
+		m.r = nil
+	}
+	untrackMat(m.p)
+}
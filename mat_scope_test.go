@@ -0,0 +1,105 @@
+package gocv
+
+import (
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	scope := NewScope()
+	defer scope.Close()
+
+	mat := scope.NewMatWithSize(10, 10, MatTypeCV8UC3)
+	if mat.Empty() {
+		t.Error("Scope NewMatWithSize should not be empty")
+	}
+
+	channels := scope.AddMats(Split(mat))
+	if len(channels) != 3 {
+		t.Fatalf("invalid number of channels: %v", len(channels))
+	}
+
+	empty := scope.NewMat()
+	if scope.Len() != 5 {
+		t.Errorf("Scope should track 5 Mats, got %v", scope.Len())
+	}
+
+	empty.Close()
+	if scope.Len() != 4 {
+		t.Errorf("closed Mat should no longer be tracked, got %v", scope.Len())
+	}
+
+	scope.Close()
+	if scope.Len() != 0 {
+		t.Errorf("Scope should be empty after Close, got %v", scope.Len())
+	}
+
+	reused := scope.NewMat()
+	if reused.Ptr() == nil || scope.Len() != 1 {
+		t.Errorf("Scope should be reusable after Close, got %v", scope.Len())
+	}
+}
+
+func TestScopeMove(t *testing.T) {
+	outer := NewScope()
+	defer outer.Close()
+
+	var result Mat
+	func() {
+		inner := NewScope()
+		defer inner.Close()
+
+		tmp := inner.NewMatWithSize(2, 2, MatTypeCV32F)
+		result = outer.Add(tmp.Clone())
+		inner.Add(NewMat())
+
+		moved := inner.NewMat()
+		outer.Add(moved)
+	}()
+
+	if result.Empty() {
+		t.Error("Mat added to the outer Scope should not be closed by the inner Scope")
+	}
+	if outer.Len() != 2 {
+		t.Errorf("outer Scope should track 2 Mats, got %v", outer.Len())
+	}
+}
+
+func TestMatFinalizers(t *testing.T) {
+	SetMatFinalizers(true)
+	mat := NewMat()
+	SetMatFinalizers(false)
+
+	if mat.r == nil {
+		t.Fatal("Mat created with finalizers enabled should have a finalizer")
+	}
+
+	cp := mat
+	mat.Close()
+	if mat.r != nil {
+		t.Error("Close should remove the finalizer")
+	}
+	if cp.r == nil {
+		t.Fatal("copy should share the finalizer reference")
+	}
+
+	other := NewMat()
+	defer other.Close()
+	if other.r != nil {
+		t.Error("Mat created with finalizers disabled should not have a finalizer")
+	}
+}
+
+func TestMatFinalizerReportsLeak(t *testing.T) {
+	mat := NewMatWithSize(64, 64, MatTypeCV8UC3)
+	defer mat.Close()
+
+	before := LeakedMatCount()
+	finalizeMat(&matRef{p: mat.p})
+
+	if LeakedMatCount() != before+1 {
+		t.Errorf("LeakedMatCount should be %d, got %d", before+1, LeakedMatCount())
+	}
+	if mat.Rows() != 64 || mat.Cols() != 64 {
+		t.Errorf("finalized Mat should not be closed, got size %v", mat.Size())
+	}
+}
@@ -0,0 +1,286 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "core.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// ErrMatViewType is returned when the element type of a MatView does not
+// match the depth and number of channels of the Mat.
+var ErrMatViewType = errors.New("element type does not match Mat type")
+
+// ErrMatViewIndex is returned when an index passed to At, Set or Ptr does not
+// address an element of the Mat.
+var ErrMatViewIndex = errors.New("index out of range")
+
+// Vec2b is a 2-channel element of a MatTypeCV8UC2 Mat.
+type Vec2b [2]uint8
+
+// Vec3b is a 3-channel element of a MatTypeCV8UC3 Mat, such as a BGR pixel.
+type Vec3b [3]uint8
+
+// Vec4b is a 4-channel element of a MatTypeCV8UC4 Mat, such as a BGRA pixel.
+type Vec4b [4]uint8
+
+// Vec2s is a 2-channel element of a MatTypeCV16SC2 Mat.
+type Vec2s [2]int16
+
+// Vec3s is a 3-channel element of a MatTypeCV16SC3 Mat.
+type Vec3s [3]int16
+
+// Vec4s is a 4-channel element of a MatTypeCV16SC4 Mat.
+type Vec4s [4]int16
+
+// Vec2i is a 2-channel element of a MatTypeCV32SC2 Mat.
+type Vec2i [2]int32
+
+// Vec3i is a 3-channel element of a MatTypeCV32SC3 Mat.
+type Vec3i [3]int32
+
+// Vec4i is a 4-channel element of a MatTypeCV32SC4 Mat.
+type Vec4i [4]int32
+
+// Vec2f is a 2-channel element of a MatTypeCV32FC2 Mat.
+type Vec2f [2]float32
+
+// Vec3f is a 3-channel element of a MatTypeCV32FC3 Mat.
+type Vec3f [3]float32
+
+// Vec4f is a 4-channel element of a MatTypeCV32FC4 Mat.
+type Vec4f [4]float32
+
+// Vec6f is a 6-channel element of a 32-bit float Mat.
+type Vec6f [6]float32
+
+// Vec2d is a 2-channel element of a MatTypeCV64FC2 Mat.
+type Vec2d [2]float64
+
+// Vec3d is a 3-channel element of a MatTypeCV64FC3 Mat.
+type Vec3d [3]float64
+
+// Vec4d is a 4-channel element of a MatTypeCV64FC4 Mat.
+type Vec4d [4]float64
+
+// MatView is a typed view of the data of a Mat, that references the OpenCV
+// allocated memory without copying it.
+//
+// The element type T is validated against the Mat type once, when the view
+// is created. T is either:
+//
+// - a numeric type matching the Mat depth (uint8 for MatTypeCV8U, int8 for
+// MatTypeCV8S, uint16, int16, int32, float32 or float64), in which case a
+// multi-channel Mat gets an extra trailing dimension indexing the channel.
+//
+// - an array or struct made only of the numeric type matching the Mat depth,
+// with one field per channel, such as Vec3b or Point2f, in which case each
+// element of the view is a whole multi-channel element of the Mat.
+//
+// The view is no longer valid once the Mat has been closed or reallocated.
+// Any data that needs to be accessed after the Mat is closed must be copied
+// into Go memory.
+type MatView[T any] struct {
+	data       unsafe.Pointer
+	dims       []int
+	steps      []int
+	continuous bool
+}
+
+// NewMatView returns a MatView of the data of m with the element type T,
+// or ErrMatViewType if T does not match the type of m.
+func NewMatView[T any](m Mat) (MatView[T], error) {
+	var zero T
+	t := reflect.TypeOf(zero)
+
+	if m.Empty() {
+		return MatView[T]{}, errors.New("MatView requires a non-empty Mat")
+	}
+	if t == nil {
+		return MatView[T]{}, fmt.Errorf("%w: invalid element type", ErrMatViewType)
+	}
+
+	mt := m.Type()
+	depth := mt & 7
+	channels := m.Channels()
+
+	// the size check rejects structs with padding, that would not match
+	// the layout of the Mat data.
+	elemDepth, count, ok := matViewElemLayout(t)
+	if !ok || elemDepth != depth || t.Size() != uintptr(count)*matViewDepthSizes[depth] {
+		return MatView[T]{}, fmt.Errorf("%w: %v for %v", ErrMatViewType, t, mt)
+	}
+
+	v := MatView[T]{
+		data:       unsafe.Pointer(C.Mat_DataPtr(m.p).data),
+		dims:       m.Size(),
+		steps:      m.Steps(),
+		continuous: m.IsContinuous(),
+	}
+
+	switch {
+	case count == channels:
+	case count == 1:
+		v.dims = append(v.dims, channels)
+		v.steps = append(v.steps, int(t.Size()))
+	default:
+		return MatView[T]{}, fmt.Errorf("%w: %v for %v", ErrMatViewType, t, mt)
+	}
+
+	return v, nil
+}
+
+// matViewDepthSizes is the size in bytes of a value of each Mat depth.
+var matViewDepthSizes = [...]uintptr{1, 1, 2, 2, 4, 4, 8}
+
+// matViewElemLayout returns the Mat depth matching t and the number of
+// values of that depth t is made of.
+func matViewElemLayout(t reflect.Type) (depth MatType, count int, ok bool) {
+	switch t.Kind() {
+	case reflect.Uint8:
+		return MatTypeCV8U, 1, true
+	case reflect.Int8:
+		return MatTypeCV8S, 1, true
+	case reflect.Uint16:
+		return MatTypeCV16U, 1, true
+	case reflect.Int16:
+		return MatTypeCV16S, 1, true
+	case reflect.Int32:
+		return MatTypeCV32S, 1, true
+	case reflect.Float32:
+		return MatTypeCV32F, 1, true
+	case reflect.Float64:
+		return MatTypeCV64F, 1, true
+	case reflect.Array:
+		if t.Len() == 0 {
+			return 0, 0, false
+		}
+		depth, count, ok = matViewElemLayout(t.Elem())
+		return depth, count * t.Len(), ok
+	case reflect.Struct:
+		if t.NumField() == 0 {
+			return 0, 0, false
+		}
+		for i := 0; i < t.NumField(); i++ {
+			d, c, fok := matViewElemLayout(t.Field(i).Type)
+			if !fok || (i > 0 && d != depth) {
+				return 0, 0, false
+			}
+			depth = d
+			count += c
+		}
+		return depth, count, true
+	}
+	return 0, 0, false
+}
+
+// Dims returns the size of each dimension of the view. For a view with a
+// numeric element type of a multi-channel Mat, the last dimension is the
+// number of channels.
+func (v MatView[T]) Dims() []int {
+	return append([]int(nil), v.dims...)
+}
+
+// offset returns the byte offset of the element at idx.
+func (v MatView[T]) offset(idx []int) (uintptr, error) {
+	if len(idx) != len(v.dims) {
+		return 0, fmt.Errorf("%w: %d indices for %d dimensions", ErrMatViewIndex, len(idx), len(v.dims))
+	}
+
+	var off uintptr
+	for i, x := range idx {
+		if x < 0 || x >= v.dims[i] {
+			return 0, fmt.Errorf("%w: index %d of dimension %d with size %d", ErrMatViewIndex, x, i, v.dims[i])
+		}
+		off += uintptr(x * v.steps[i])
+	}
+	return off, nil
+}
+
+// Ptr returns a pointer to the element at idx, with one index per dimension.
+// It panics if idx is out of range.
+func (v MatView[T]) Ptr(idx ...int) *T {
+	off, err := v.offset(idx)
+	if err != nil {
+		panic(err)
+	}
+	return (*T)(unsafe.Add(v.data, off))
+}
+
+// At returns the element at idx, with one index per dimension.
+// It panics if idx is out of range.
+func (v MatView[T]) At(idx ...int) T {
+	return *v.Ptr(idx...)
+}
+
+// Set sets the element at idx, with one index per dimension, to val.
+// It panics if idx is out of range.
+func (v MatView[T]) Set(val T, idx ...int) {
+	*v.Ptr(idx...) = val
+}
+
+// Slice returns a slice of all the elements of the view that references the
+// OpenCV allocated data. It requires a continuous Mat.
+func (v MatView[T]) Slice() ([]T, error) {
+	if !v.continuous {
+		return nil, errors.New("Slice requires continuous Mat")
+	}
+
+	n := 1
+	for _, d := range v.dims {
+		n *= d
+	}
+	return unsafe.Slice((*T)(v.data), n), nil
+}
+
+// At returns the element of m at idx, with one index per dimension,
+// or an error if T does not match the type of m or idx is out of range.
+func At[T any](m Mat, idx ...int) (T, error) {
+	var zero T
+
+	v, err := NewMatView[T](m)
+	if err != nil {
+		return zero, err
+	}
+
+	off, err := v.offset(idx)
+	if err != nil {
+		return zero, err
+	}
+	return *(*T)(unsafe.Add(v.data, off)), nil
+}
+
+// Set sets the element of m at idx, with one index per dimension, to val,
+// or returns an error if T does not match the type of m or idx is out of range.
+func Set[T any](m Mat, val T, idx ...int) error {
+	v, err := NewMatView[T](m)
+	if err != nil {
+		return err
+	}
+
+	off, err := v.offset(idx)
+	if err != nil {
+		return err
+	}
+	*(*T)(unsafe.Add(v.data, off)) = val
+	return nil
+}
+
+// Slice returns a slice of all the elements of m that references the OpenCV
+// allocated data, or an error if T does not match the type of m or m is not
+// continuous.
+//
+// The data is no longer valid once the Mat has been closed. Any data that
+// needs to be accessed after the Mat is closed must be copied into Go memory.
+func Slice[T any](m Mat) ([]T, error) {
+	v, err := NewMatView[T](m)
+	if err != nil {
+		return nil, err
+	}
+	return v.Slice()
+}
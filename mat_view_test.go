@@ -0,0 +1,153 @@
+package gocv
+
+import (
+	"errors"
+	"image"
+	"testing"
+)
+
+func TestMatView(t *testing.T) {
+	mat := NewMatWithSize(3, 4, MatTypeCV32F)
+	defer mat.Close()
+
+	v, err := NewMatView[float32](mat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dims := v.Dims()
+	if len(dims) != 2 || dims[0] != 3 || dims[1] != 4 {
+		t.Fatalf("invalid dims: %v", dims)
+	}
+
+	v.Set(2.5, 1, 2)
+	if v.At(1, 2) != 2.5 || mat.GetFloatAt(1, 2) != 2.5 {
+		t.Errorf("invalid value: %v", v.At(1, 2))
+	}
+
+	*v.Ptr(2, 3) = 7
+	if mat.GetFloatAt(2, 3) != 7 {
+		t.Errorf("invalid value set through Ptr: %v", mat.GetFloatAt(2, 3))
+	}
+
+	s, err := v.Slice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s) != 12 || s[1*4+2] != 2.5 {
+		t.Errorf("invalid slice: %v", s)
+	}
+
+	if _, err := NewMatView[uint8](mat); !errors.Is(err, ErrMatViewType) {
+		t.Errorf("expected ErrMatViewType for wrong depth, got %v", err)
+	}
+	if _, err := NewMatView[float64](mat); !errors.Is(err, ErrMatViewType) {
+		t.Errorf("expected ErrMatViewType for wrong depth, got %v", err)
+	}
+
+	empty := NewMat()
+	defer empty.Close()
+	if _, err := NewMatView[float32](empty); err == nil {
+		t.Error("expected error for empty Mat")
+	}
+}
+
+func TestMatViewMultiChannel(t *testing.T) {
+	mat := NewMatWithSizeFromScalar(NewScalar(1, 2, 3, 0), 2, 2, MatTypeCV8UC3)
+	defer mat.Close()
+
+	v, err := NewMatView[Vec3b](mat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if px := v.At(1, 1); px != (Vec3b{1, 2, 3}) {
+		t.Errorf("invalid pixel: %v", px)
+	}
+	v.Set(Vec3b{10, 20, 30}, 0, 1)
+
+	c, err := NewMatView[uint8](mat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dims := c.Dims(); len(dims) != 3 || dims[2] != 3 {
+		t.Fatalf("invalid dims: %v", dims)
+	}
+	if c.At(0, 1, 2) != 30 {
+		t.Errorf("invalid channel value: %v", c.At(0, 1, 2))
+	}
+
+	type bgr struct{ B, G, R uint8 }
+	px, err := At[bgr](mat, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if px.B != 10 || px.G != 20 || px.R != 30 {
+		t.Errorf("invalid struct pixel: %v", px)
+	}
+
+	if _, err := NewMatView[Vec4b](mat); !errors.Is(err, ErrMatViewType) {
+		t.Errorf("expected ErrMatViewType for wrong channels, got %v", err)
+	}
+	type padded struct {
+		A uint8
+		B int32
+	}
+	if _, err := NewMatView[padded](mat); !errors.Is(err, ErrMatViewType) {
+		t.Errorf("expected ErrMatViewType for mixed struct, got %v", err)
+	}
+
+	pts := NewMatWithSize(1, 2, MatTypeCV32FC2)
+	defer pts.Close()
+	if err := Set(pts, Point2f{X: 1.5, Y: -2}, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	p, err := Slice[Point2f](pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 2 || p[1].X != 1.5 || p[1].Y != -2 {
+		t.Errorf("invalid points: %v", p)
+	}
+}
+
+func TestMatViewNDims(t *testing.T) {
+	mat := NewMatWithSizes([]int{2, 3, 4}, MatTypeCV16S)
+	defer mat.Close()
+
+	if err := Set[int16](mat, -12, 1, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	val, err := At[int16](mat, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val != -12 {
+		t.Errorf("invalid value: %v", val)
+	}
+
+	if _, err := At[int16](mat, 1, 2); !errors.Is(err, ErrMatViewIndex) {
+		t.Errorf("expected ErrMatViewIndex for missing index, got %v", err)
+	}
+	if _, err := At[int16](mat, 2, 0, 0); !errors.Is(err, ErrMatViewIndex) {
+		t.Errorf("expected ErrMatViewIndex for out of range index, got %v", err)
+	}
+}
+
+func TestMatViewRegion(t *testing.T) {
+	mat := NewMatWithSize(4, 4, MatTypeCV8U)
+	defer mat.Close()
+
+	region := mat.Region(image.Rect(1, 1, 3, 3))
+	defer region.Close()
+
+	if err := Set[uint8](region, 42, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if mat.GetUCharAt(1, 2) != 42 {
+		t.Errorf("invalid value set in region: %v", mat.GetUCharAt(1, 2))
+	}
+
+	if _, err := Slice[uint8](region); err == nil {
+		t.Error("expected error for non-continuous Mat")
+	}
+}
@@ -0,0 +1,82 @@
+#include "optim.h"
+
+// GoMinProblemFunction forwards the evaluation of the objective function,
+// and optionally its gradient, to a Go function registered under fn.
+class GoMinProblemFunction : public cv::MinProblemSolver::Function {
+public:
+    GoMinProblemFunction(int fn, int dims, bool hasGradient) :
+        fn(fn), dims(dims), hasGradient(hasGradient) {}
+
+    int getDims() const {
+        return dims;
+    }
+
+    double calc(const double* x) const {
+        return goMinProblemSolverCalc(fn, const_cast<double*>(x), dims);
+    }
+
+    void getGradient(const double* x, double* grad) {
+        if (!hasGradient) {
+            cv::MinProblemSolver::Function::getGradient(x, grad);
+            return;
+        }
+        goMinProblemSolverGradient(fn, const_cast<double*>(x), grad, dims);
+    }
+
+private:
+    int fn;
+    int dims;
+    bool hasGradient;
+};
+
+int SolveLP(Mat func, Mat constr, Mat z) {
+    return cv::solveLP(*func, *constr, *z);
+}
+
+DownhillSolver DownhillSolver_Create() {
+    return new cv::Ptr<cv::DownhillSolver>(cv::DownhillSolver::create());
+}
+
+void DownhillSolver_Close(DownhillSolver ds) {
+    delete ds;
+}
+
+void DownhillSolver_SetFunction(DownhillSolver ds, int fn, int dims, bool hasGradient) {
+    (*ds)->setFunction(cv::makePtr<GoMinProblemFunction>(fn, dims, hasGradient));
+}
+
+void DownhillSolver_SetInitStep(DownhillSolver ds, Mat step) {
+    (*ds)->setInitStep(*step);
+}
+
+void DownhillSolver_GetInitStep(DownhillSolver ds, Mat step) {
+    (*ds)->getInitStep(*step);
+}
+
+void DownhillSolver_SetTermCriteria(DownhillSolver ds, TermCriteria termcrit) {
+    (*ds)->setTermCriteria(*termcrit);
+}
+
+double DownhillSolver_Minimize(DownhillSolver ds, Mat x) {
+    return (*ds)->minimize(*x);
+}
+
+ConjGradSolver ConjGradSolver_Create() {
+    return new cv::Ptr<cv::ConjGradSolver>(cv::ConjGradSolver::create());
+}
+
+void ConjGradSolver_Close(ConjGradSolver cgs) {
+    delete cgs;
+}
+
+void ConjGradSolver_SetFunction(ConjGradSolver cgs, int fn, int dims, bool hasGradient) {
+    (*cgs)->setFunction(cv::makePtr<GoMinProblemFunction>(fn, dims, hasGradient));
+}
+
+void ConjGradSolver_SetTermCriteria(ConjGradSolver cgs, TermCriteria termcrit) {
+    (*cgs)->setTermCriteria(*termcrit);
+}
+
+double ConjGradSolver_Minimize(ConjGradSolver cgs, Mat x) {
+    return (*cgs)->minimize(*x);
+}
@@ -0,0 +1,249 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "optim.h"
+*/
+import "C"
+import (
+	"sync"
+	"unsafe"
+)
+
+// SolveLPResult is the return code of SolveLP.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d01/group__core__optim.html
+//
+type SolveLPResult int
+
+const (
+	// SolveLPUnbounded means the problem is unbounded (the target function can achieve arbitrary high values).
+	SolveLPUnbounded SolveLPResult = -2
+
+	// SolveLPUnfeasible means the problem is unfeasible (there are no points that satisfy all the constraints imposed).
+	SolveLPUnfeasible SolveLPResult = -1
+
+	// SolveLPSingle means there is only one maximum for the target function.
+	SolveLPSingle SolveLPResult = 0
+
+	// SolveLPMulti means there are multiple maxima for the target function, the arbitrary one is returned.
+	SolveLPMulti SolveLPResult = 1
+)
+
+// SolveLP solves the given (non-integer) linear programming problem using the
+// Simplex Algorithm (Simplex Method). It maximizes func*z subject to the
+// constraints in constr, where each row holds the coefficients of one
+// inequality followed by its right-hand side, and z >= 0.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d01/group__core__optim.html#ga9a06d237a9d38ace891efa1ca1b5d00a
+//
+func SolveLP(function Mat, constr Mat, z *Mat) SolveLPResult {
+	return SolveLPResult(C.SolveLP(function.p, constr.p, z.p))
+}
+
+// MinProblemSolverFunction is the function minimized by DownhillSolver
+// and ConjGradSolver. Calc and Gradient are invoked from the solver's
+// Minimize call, on the same goroutine.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d43/classcv_1_1DownhillSolver.html
+//
+type MinProblemSolverFunction struct {
+	// Dims is the number of dimensions of the function domain.
+	Dims int
+
+	// Calc evaluates the function at x.
+	Calc func(x []float64) float64
+
+	// Gradient computes the gradient of the function at x into grad.
+	// If nil, the gradient is approximated numerically using Calc.
+	Gradient func(x []float64, grad []float64)
+}
+
+// minProblemSolverFunctions holds the Go functions currently set on a
+// solver, so that they can be looked up from the C++ callbacks by handle.
+var minProblemSolverFunctions = struct {
+	sync.RWMutex
+	next int
+	fns  map[int]MinProblemSolverFunction
+}{fns: make(map[int]MinProblemSolverFunction)}
+
+func registerMinProblemSolverFunction(f MinProblemSolverFunction) int {
+	minProblemSolverFunctions.Lock()
+	defer minProblemSolverFunctions.Unlock()
+
+	minProblemSolverFunctions.next++
+	fn := minProblemSolverFunctions.next
+	minProblemSolverFunctions.fns[fn] = f
+	return fn
+}
+
+func unregisterMinProblemSolverFunction(fn int) {
+	minProblemSolverFunctions.Lock()
+	defer minProblemSolverFunctions.Unlock()
+
+	delete(minProblemSolverFunctions.fns, fn)
+}
+
+func lookupMinProblemSolverFunction(fn int) MinProblemSolverFunction {
+	minProblemSolverFunctions.RLock()
+	defer minProblemSolverFunctions.RUnlock()
+
+	return minProblemSolverFunctions.fns[fn]
+}
+
+// toFloat64Slice returns a slice backed by the C array p of length doubles.
+func toFloat64Slice(p *C.double, length C.int) []float64 {
+	return unsafe.Slice((*float64)(unsafe.Pointer(p)), int(length))
+}
+
+//export goMinProblemSolverCalc
+func goMinProblemSolverCalc(fn C.int, x *C.double, dims C.int) C.double {
+	f := lookupMinProblemSolverFunction(int(fn))
+	return C.double(f.Calc(toFloat64Slice(x, dims)))
+}
+
+//export goMinProblemSolverGradient
+func goMinProblemSolverGradient(fn C.int, x *C.double, grad *C.double, dims C.int) {
+	f := lookupMinProblemSolverFunction(int(fn))
+	f.Gradient(toFloat64Slice(x, dims), toFloat64Slice(grad, dims))
+}
+
+// DownhillSolver is a wrapper around the cv::DownhillSolver algorithm, which
+// minimizes a function using the Nelder-Mead simplex method. It does not
+// require the gradient of the function.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d43/classcv_1_1DownhillSolver.html
+//
+type DownhillSolver struct {
+	p  C.DownhillSolver
+	fn int
+}
+
+// NewDownhillSolver returns a new DownhillSolver. SetFunction and
+// SetInitStep must be called before Minimize.
+func NewDownhillSolver() DownhillSolver {
+	return DownhillSolver{p: C.DownhillSolver_Create()}
+}
+
+// NewDownhillSolverWithParams returns a new DownhillSolver for the function f,
+// with the initial simplex step initStep and the termination criteria termcrit.
+func NewDownhillSolverWithParams(f MinProblemSolverFunction, initStep Mat, termcrit TermCriteria) DownhillSolver {
+	ds := NewDownhillSolver()
+	ds.SetFunction(f)
+	ds.SetInitStep(initStep)
+	ds.SetTermCriteria(termcrit)
+	return ds
+}
+
+// Close DownhillSolver.
+func (ds *DownhillSolver) Close() error {
+	C.DownhillSolver_Close(ds.p)
+	ds.p = nil
+	unregisterMinProblemSolverFunction(ds.fn)
+	ds.fn = 0
+	return nil
+}
+
+// SetFunction sets the function to be minimized.
+func (ds *DownhillSolver) SetFunction(f MinProblemSolverFunction) {
+	fn := registerMinProblemSolverFunction(f)
+	C.DownhillSolver_SetFunction(ds.p, C.int(fn), C.int(f.Dims), C.bool(f.Gradient != nil))
+	unregisterMinProblemSolverFunction(ds.fn)
+	ds.fn = fn
+}
+
+// SetInitStep sets the initial step that will be used to construct the
+// initial simplex. It must be a row or column vector of f.Dims elements.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d43/classcv_1_1DownhillSolver.html
+//
+func (ds *DownhillSolver) SetInitStep(step Mat) {
+	C.DownhillSolver_SetInitStep(ds.p, step.p)
+}
+
+// GetInitStep returns the initial step that will be used to construct the
+// initial simplex.
+func (ds *DownhillSolver) GetInitStep(step *Mat) {
+	C.DownhillSolver_GetInitStep(ds.p, step.p)
+}
+
+// SetTermCriteria sets the termination criteria of the solver.
+func (ds *DownhillSolver) SetTermCriteria(termcrit TermCriteria) {
+	C.DownhillSolver_SetTermCriteria(ds.p, termcrit.p)
+}
+
+// Minimize runs the algorithm starting from x, which must be a CV64F row or
+// column vector, and stores the found minimum in x. It returns the value of
+// the function at that point.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d4/d43/classcv_1_1DownhillSolver.html
+//
+func (ds *DownhillSolver) Minimize(x *Mat) float64 {
+	return float64(C.DownhillSolver_Minimize(ds.p, x.p))
+}
+
+// ConjGradSolver is a wrapper around the cv::ConjGradSolver algorithm, which
+// minimizes a smooth function using the nonlinear conjugate gradient method.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d0/d21/classcv_1_1ConjGradSolver.html
+//
+type ConjGradSolver struct {
+	p  C.ConjGradSolver
+	fn int
+}
+
+// NewConjGradSolver returns a new ConjGradSolver. SetFunction must be
+// called before Minimize.
+func NewConjGradSolver() ConjGradSolver {
+	return ConjGradSolver{p: C.ConjGradSolver_Create()}
+}
+
+// NewConjGradSolverWithParams returns a new ConjGradSolver for the function f,
+// with the termination criteria termcrit.
+func NewConjGradSolverWithParams(f MinProblemSolverFunction, termcrit TermCriteria) ConjGradSolver {
+	cgs := NewConjGradSolver()
+	cgs.SetFunction(f)
+	cgs.SetTermCriteria(termcrit)
+	return cgs
+}
+
+// Close ConjGradSolver.
+func (cgs *ConjGradSolver) Close() error {
+	C.ConjGradSolver_Close(cgs.p)
+	cgs.p = nil
+	unregisterMinProblemSolverFunction(cgs.fn)
+	cgs.fn = 0
+	return nil
+}
+
+// SetFunction sets the function to be minimized. If f.Gradient is nil, the
+// gradient is approximated numerically.
+func (cgs *ConjGradSolver) SetFunction(f MinProblemSolverFunction) {
+	fn := registerMinProblemSolverFunction(f)
+	C.ConjGradSolver_SetFunction(cgs.p, C.int(fn), C.int(f.Dims), C.bool(f.Gradient != nil))
+	unregisterMinProblemSolverFunction(cgs.fn)
+	cgs.fn = fn
+}
+
+// SetTermCriteria sets the termination criteria of the solver.
+func (cgs *ConjGradSolver) SetTermCriteria(termcrit TermCriteria) {
+	C.ConjGradSolver_SetTermCriteria(cgs.p, termcrit.p)
+}
+
+// Minimize runs the algorithm starting from x, which must be a CV64F row or
+// column vector, and stores the found minimum in x. It returns the value of
+// the function at that point.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d0/d21/classcv_1_1ConjGradSolver.html
+//
+func (cgs *ConjGradSolver) Minimize(x *Mat) float64 {
+	return float64(C.ConjGradSolver_Minimize(cgs.p, x.p))
+}
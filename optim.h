@@ -0,0 +1,45 @@
+#ifndef _OPENCV3_OPTIM_H_
+#define _OPENCV3_OPTIM_H_
+
+#include <stdbool.h>
+
+#ifdef __cplusplus
+#include <opencv2/opencv.hpp>
+extern "C" {
+#endif
+
+#include "core.h"
+
+#ifdef __cplusplus
+typedef cv::Ptr<cv::DownhillSolver>* DownhillSolver;
+typedef cv::Ptr<cv::ConjGradSolver>* ConjGradSolver;
+#else
+typedef void* DownhillSolver;
+typedef void* ConjGradSolver;
+#endif
+
+int SolveLP(Mat func, Mat constr, Mat z);
+
+DownhillSolver DownhillSolver_Create();
+void DownhillSolver_Close(DownhillSolver ds);
+void DownhillSolver_SetFunction(DownhillSolver ds, int fn, int dims, bool hasGradient);
+void DownhillSolver_SetInitStep(DownhillSolver ds, Mat step);
+void DownhillSolver_GetInitStep(DownhillSolver ds, Mat step);
+void DownhillSolver_SetTermCriteria(DownhillSolver ds, TermCriteria termcrit);
+double DownhillSolver_Minimize(DownhillSolver ds, Mat x);
+
+ConjGradSolver ConjGradSolver_Create();
+void ConjGradSolver_Close(ConjGradSolver cgs);
+void ConjGradSolver_SetFunction(ConjGradSolver cgs, int fn, int dims, bool hasGradient);
+void ConjGradSolver_SetTermCriteria(ConjGradSolver cgs, TermCriteria termcrit);
+double ConjGradSolver_Minimize(ConjGradSolver cgs, Mat x);
+
+// Implemented in Go, see optim.go
+extern double goMinProblemSolverCalc(int fn, double* x, int dims);
+extern void goMinProblemSolverGradient(int fn, double* x, double* grad, int dims);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif //_OPENCV3_OPTIM_H_
@@ -0,0 +1,109 @@
+package gocv
+
+import (
+	"math"
+	"testing"
+)
+
+// quadratic has its minimum of 0 at (1, 2).
+var quadratic = MinProblemSolverFunction{
+	Dims: 2,
+	Calc: func(x []float64) float64 {
+		return (x[0]-1)*(x[0]-1) + (x[1]-2)*(x[1]-2)
+	},
+}
+
+func TestSolveLP(t *testing.T) {
+	// maximize x + y subject to x <= 1, y <= 2
+	function := NewMatWithSize(1, 2, MatTypeCV64F)
+	defer function.Close()
+	function.SetDoubleAt(0, 0, 1)
+	function.SetDoubleAt(0, 1, 1)
+
+	constr := NewMatWithSize(2, 3, MatTypeCV64F)
+	defer constr.Close()
+	constr.SetDoubleAt(0, 0, 1)
+	constr.SetDoubleAt(0, 2, 1)
+	constr.SetDoubleAt(1, 1, 1)
+	constr.SetDoubleAt(1, 2, 2)
+
+	z := NewMat()
+	defer z.Close()
+
+	res := SolveLP(function, constr, &z)
+	if res != SolveLPSingle {
+		t.Fatalf("invalid SolveLP result: %v", res)
+	}
+	if z.Total() != 2 || math.Abs(z.GetDoubleAt(0, 0)-1) > 1e-9 || math.Abs(z.GetDoubleAt(1, 0)-2) > 1e-9 {
+		t.Errorf("invalid SolveLP solution: %v %v", z.GetDoubleAt(0, 0), z.GetDoubleAt(1, 0))
+	}
+}
+
+func TestDownhillSolver(t *testing.T) {
+	step := NewMatWithSize(1, 2, MatTypeCV64F)
+	defer step.Close()
+	step.SetDoubleAt(0, 0, 0.5)
+	step.SetDoubleAt(0, 1, 0.5)
+
+	solver := NewDownhillSolverWithParams(quadratic, step, NewTermCriteria(Count+EPS, 5000, 1e-10))
+	defer solver.Close()
+
+	initStep := NewMat()
+	defer initStep.Close()
+	solver.GetInitStep(&initStep)
+	if initStep.Total() != 2 || initStep.GetDoubleAt(0, 1) != 0.5 {
+		t.Error("invalid DownhillSolver init step")
+	}
+
+	x := NewMatWithSize(1, 2, MatTypeCV64F)
+	defer x.Close()
+
+	res := solver.Minimize(&x)
+	if res > 1e-6 {
+		t.Errorf("invalid DownhillSolver minimum value: %v", res)
+	}
+	if math.Abs(x.GetDoubleAt(0, 0)-1) > 1e-3 || math.Abs(x.GetDoubleAt(0, 1)-2) > 1e-3 {
+		t.Errorf("invalid DownhillSolver minimum: %v %v", x.GetDoubleAt(0, 0), x.GetDoubleAt(0, 1))
+	}
+}
+
+func TestConjGradSolver(t *testing.T) {
+	calls := 0
+	f := quadratic
+	f.Gradient = func(x []float64, grad []float64) {
+		calls++
+		grad[0] = 2 * (x[0] - 1)
+		grad[1] = 2 * (x[1] - 2)
+	}
+
+	solver := NewConjGradSolverWithParams(f, NewTermCriteria(Count+EPS, 5000, 1e-10))
+	defer solver.Close()
+
+	x := NewMatWithSize(1, 2, MatTypeCV64F)
+	defer x.Close()
+
+	res := solver.Minimize(&x)
+	if res > 1e-6 {
+		t.Errorf("invalid ConjGradSolver minimum value: %v", res)
+	}
+	if math.Abs(x.GetDoubleAt(0, 0)-1) > 1e-3 || math.Abs(x.GetDoubleAt(0, 1)-2) > 1e-3 {
+		t.Errorf("invalid ConjGradSolver minimum: %v %v", x.GetDoubleAt(0, 0), x.GetDoubleAt(0, 1))
+	}
+	if calls == 0 {
+		t.Error("ConjGradSolver should have called the Go gradient function")
+	}
+}
+
+func TestConjGradSolverNumericalGradient(t *testing.T) {
+	solver := NewConjGradSolver()
+	defer solver.Close()
+	solver.SetFunction(quadratic)
+
+	x := NewMatWithSize(1, 2, MatTypeCV64F)
+	defer x.Close()
+
+	solver.Minimize(&x)
+	if math.Abs(x.GetDoubleAt(0, 0)-1) > 1e-3 || math.Abs(x.GetDoubleAt(0, 1)-2) > 1e-3 {
+		t.Errorf("invalid ConjGradSolver minimum: %v %v", x.GetDoubleAt(0, 0), x.GetDoubleAt(0, 1))
+	}
+}
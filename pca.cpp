@@ -0,0 +1,61 @@
+#include "pca.h"
+
+PCA PCA_New() {
+    return new cv::PCA();
+}
+
+PCA PCA_NewWithMaxComponents(Mat data, Mat mean, int flags, int maxComponents) {
+    return new cv::PCA(*data, *mean, flags, maxComponents);
+}
+
+PCA PCA_NewWithRetainedVariance(Mat data, Mat mean, int flags, double retainedVariance) {
+    return new cv::PCA(*data, *mean, flags, retainedVariance);
+}
+
+void PCA_Close(PCA pca) {
+    delete pca;
+}
+
+void PCA_Compute(PCA pca, Mat data, Mat mean, int flags, int maxComponents) {
+    (*pca)(*data, *mean, flags, maxComponents);
+}
+
+void PCA_ComputeWithRetainedVariance(PCA pca, Mat data, Mat mean, int flags, double retainedVariance) {
+    (*pca)(*data, *mean, flags, retainedVariance);
+}
+
+void PCA_Project(PCA pca, Mat vec, Mat result) {
+    pca->project(*vec, *result);
+}
+
+void PCA_BackProject(PCA pca, Mat vec, Mat result) {
+    pca->backProject(*vec, *result);
+}
+
+Mat PCA_Mean(PCA pca) {
+    return new cv::Mat(pca->mean);
+}
+
+Mat PCA_Eigenvectors(PCA pca) {
+    return new cv::Mat(pca->eigenvectors);
+}
+
+Mat PCA_Eigenvalues(PCA pca) {
+    return new cv::Mat(pca->eigenvalues);
+}
+
+void PCACompute(Mat data, Mat mean, Mat eigenvectors, Mat eigenvalues, int maxComponents) {
+    cv::PCACompute(*data, *mean, *eigenvectors, *eigenvalues, maxComponents);
+}
+
+void PCAComputeWithRetainedVariance(Mat data, Mat mean, Mat eigenvectors, Mat eigenvalues, double retainedVariance) {
+    cv::PCACompute(*data, *mean, *eigenvectors, *eigenvalues, retainedVariance);
+}
+
+void PCAProject(Mat data, Mat mean, Mat eigenvectors, Mat result) {
+    cv::PCAProject(*data, *mean, *eigenvectors, *result);
+}
+
+void PCABackProject(Mat data, Mat mean, Mat eigenvectors, Mat result) {
+    cv::PCABackProject(*data, *mean, *eigenvectors, *result);
+}
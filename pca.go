@@ -0,0 +1,165 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "pca.h"
+*/
+import "C"
+
+// PCAFlags are the operation flags for PCA.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+type PCAFlags int
+
+const (
+	// PCADataAsRow indicates that the input samples are stored as matrix rows.
+	PCADataAsRow PCAFlags = 0
+
+	// PCADataAsCol indicates that the input samples are stored as matrix columns.
+	PCADataAsCol PCAFlags = 1
+
+	// PCAUseAvg indicates that the mean passed in is used instead of being
+	// computed from the data.
+	PCAUseAvg PCAFlags = 2
+)
+
+// PCA is a wrapper around the cv::PCA class for Principal Component Analysis.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+type PCA struct {
+	p C.PCA
+}
+
+// NewPCA returns a new empty PCA. Use Compute or ComputeWithRetainedVariance
+// to perform the analysis.
+func NewPCA() PCA {
+	return PCA{p: C.PCA_New()}
+}
+
+// NewPCAWithMaxComponents returns a new PCA computed from data, keeping at
+// most maxComponents components. A maxComponents value of 0 keeps all of them.
+// Pass an empty Mat as mean to have it computed from the data.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func NewPCAWithMaxComponents(data, mean Mat, flags PCAFlags, maxComponents int) PCA {
+	return PCA{p: C.PCA_NewWithMaxComponents(data.p, mean.p, C.int(flags), C.int(maxComponents))}
+}
+
+// NewPCAWithRetainedVariance returns a new PCA computed from data, keeping
+// the smallest number of components that retain at least the given
+// fraction (0-1) of the variance.
+// Pass an empty Mat as mean to have it computed from the data.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func NewPCAWithRetainedVariance(data, mean Mat, flags PCAFlags, retainedVariance float64) PCA {
+	return PCA{p: C.PCA_NewWithRetainedVariance(data.p, mean.p, C.int(flags), C.double(retainedVariance))}
+}
+
+// Close deletes the PCA's pointer.
+func (p *PCA) Close() error {
+	C.PCA_Close(p.p)
+	p.p = nil
+	return nil
+}
+
+// Compute performs PCA on data, keeping at most maxComponents components.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func (p *PCA) Compute(data, mean Mat, flags PCAFlags, maxComponents int) {
+	C.PCA_Compute(p.p, data.p, mean.p, C.int(flags), C.int(maxComponents))
+}
+
+// ComputeWithRetainedVariance performs PCA on data, keeping the smallest
+// number of components that retain at least the given fraction of the variance.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func (p *PCA) ComputeWithRetainedVariance(data, mean Mat, flags PCAFlags, retainedVariance float64) {
+	C.PCA_ComputeWithRetainedVariance(p.p, data.p, mean.p, C.int(flags), C.double(retainedVariance))
+}
+
+// Project projects vectors to the principal component subspace.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func (p *PCA) Project(vec Mat, result *Mat) {
+	C.PCA_Project(p.p, vec.p, result.p)
+}
+
+// BackProject reconstructs vectors from their principal component projections.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d3/d8d/classcv_1_1PCA.html
+//
+func (p *PCA) BackProject(vec Mat, result *Mat) {
+	C.PCA_BackProject(p.p, vec.p, result.p)
+}
+
+// Mean returns the mean vector computed by the PCA.
+// The returned Mat shares data with the PCA and must be closed.
+func (p *PCA) Mean() Mat {
+	return newMat(C.PCA_Mean(p.p))
+}
+
+// Eigenvectors returns the eigenvectors of the covariance matrix, one per row.
+// The returned Mat shares data with the PCA and must be closed.
+func (p *PCA) Eigenvectors() Mat {
+	return newMat(C.PCA_Eigenvectors(p.p))
+}
+
+// Eigenvalues returns the eigenvalues of the covariance matrix, in descending order.
+// The returned Mat shares data with the PCA and must be closed.
+func (p *PCA) Eigenvalues() Mat {
+	return newMat(C.PCA_Eigenvalues(p.p))
+}
+
+// PCACompute performs PCA on data stored as rows, keeping at most
+// maxComponents components.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga4e2073c7311f292a0648f04c37b73781
+//
+func PCACompute(data Mat, mean, eigenvectors, eigenvalues *Mat, maxComponents int) {
+	C.PCACompute(data.p, mean.p, eigenvectors.p, eigenvalues.p, C.int(maxComponents))
+}
+
+// PCAComputeWithRetainedVariance performs PCA on data stored as rows,
+// keeping the smallest number of components that retain at least the given
+// fraction of the variance.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga4e2073c7311f292a0648f04c37b73781
+//
+func PCAComputeWithRetainedVariance(data Mat, mean, eigenvectors, eigenvalues *Mat, retainedVariance float64) {
+	C.PCAComputeWithRetainedVariance(data.p, mean.p, eigenvectors.p, eigenvalues.p, C.double(retainedVariance))
+}
+
+// PCAProject projects vectors to the principal component subspace.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#ga6b9fbc7b3a99ebfd441bbec0a6bc4f88
+//
+func PCAProject(data, mean, eigenvectors Mat, result *Mat) {
+	C.PCAProject(data.p, mean.p, eigenvectors.p, result.p)
+}
+
+// PCABackProject reconstructs vectors from their principal component projections.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gab26049f30ee8e94f7d69d82c124faafc
+//
+func PCABackProject(data, mean, eigenvectors Mat, result *Mat) {
+	C.PCABackProject(data.p, mean.p, eigenvectors.p, result.p)
+}
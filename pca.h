@@ -0,0 +1,39 @@
+#ifndef _OPENCV3_PCA_H_
+#define _OPENCV3_PCA_H_
+
+#ifdef __cplusplus
+#include <opencv2/opencv.hpp>
+
+extern "C" {
+#endif
+
+#include "core.h"
+
+#ifdef __cplusplus
+typedef cv::PCA* PCA;
+#else
+typedef void* PCA;
+#endif
+
+PCA PCA_New();
+PCA PCA_NewWithMaxComponents(Mat data, Mat mean, int flags, int maxComponents);
+PCA PCA_NewWithRetainedVariance(Mat data, Mat mean, int flags, double retainedVariance);
+void PCA_Close(PCA pca);
+void PCA_Compute(PCA pca, Mat data, Mat mean, int flags, int maxComponents);
+void PCA_ComputeWithRetainedVariance(PCA pca, Mat data, Mat mean, int flags, double retainedVariance);
+void PCA_Project(PCA pca, Mat vec, Mat result);
+void PCA_BackProject(PCA pca, Mat vec, Mat result);
+Mat PCA_Mean(PCA pca);
+Mat PCA_Eigenvectors(PCA pca);
+Mat PCA_Eigenvalues(PCA pca);
+
+void PCACompute(Mat data, Mat mean, Mat eigenvectors, Mat eigenvalues, int maxComponents);
+void PCAComputeWithRetainedVariance(Mat data, Mat mean, Mat eigenvectors, Mat eigenvalues, double retainedVariance);
+void PCAProject(Mat data, Mat mean, Mat eigenvectors, Mat result);
+void PCABackProject(Mat data, Mat mean, Mat eigenvectors, Mat result);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif //_OPENCV3_PCA_H_
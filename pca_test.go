@@ -0,0 +1,128 @@
+package gocv
+
+import (
+	"math"
+	"testing"
+)
+
+func newPCATestData() Mat {
+	data := NewMatWithSize(5, 2, MatTypeCV32F)
+	for i := 0; i < 5; i++ {
+		data.SetFloatAt(i, 0, float32(i))
+		data.SetFloatAt(i, 1, float32(2*i))
+	}
+	return data
+}
+
+func TestPCA(t *testing.T) {
+	data := newPCATestData()
+	defer data.Close()
+
+	mean := NewMat()
+	defer mean.Close()
+
+	pca := NewPCAWithMaxComponents(data, mean, PCADataAsRow, 1)
+	defer pca.Close()
+
+	m := pca.Mean()
+	defer m.Close()
+	if m.Rows() != 1 || m.Cols() != 2 {
+		t.Fatalf("invalid mean size: %dx%d", m.Rows(), m.Cols())
+	}
+	if m.GetFloatAt(0, 0) != 2 || m.GetFloatAt(0, 1) != 4 {
+		t.Errorf("invalid mean: %v %v", m.GetFloatAt(0, 0), m.GetFloatAt(0, 1))
+	}
+
+	evecs := pca.Eigenvectors()
+	defer evecs.Close()
+	if evecs.Rows() != 1 || evecs.Cols() != 2 {
+		t.Errorf("invalid eigenvectors size: %dx%d", evecs.Rows(), evecs.Cols())
+	}
+
+	evals := pca.Eigenvalues()
+	defer evals.Close()
+	if evals.Total() != 1 {
+		t.Errorf("invalid eigenvalues count: %d", evals.Total())
+	}
+
+	projected := NewMat()
+	defer projected.Close()
+	pca.Project(data, &projected)
+	if projected.Rows() != 5 || projected.Cols() != 1 {
+		t.Fatalf("invalid projection size: %dx%d", projected.Rows(), projected.Cols())
+	}
+
+	reconstructed := NewMat()
+	defer reconstructed.Close()
+	pca.BackProject(projected, &reconstructed)
+	for i := 0; i < 5; i++ {
+		if math.Abs(float64(reconstructed.GetFloatAt(i, 1)-data.GetFloatAt(i, 1))) > 1e-4 {
+			t.Errorf("invalid reconstruction at row %d: %v", i, reconstructed.GetFloatAt(i, 1))
+		}
+	}
+}
+
+func TestPCAComputeWithRetainedVariance(t *testing.T) {
+	data := newPCATestData()
+	defer data.Close()
+
+	mean := NewMat()
+	defer mean.Close()
+
+	pca := NewPCA()
+	defer pca.Close()
+
+	pca.ComputeWithRetainedVariance(data, mean, PCADataAsRow, 0.95)
+
+	evecs := pca.Eigenvectors()
+	defer evecs.Close()
+	if evecs.Rows() != 1 {
+		t.Errorf("invalid number of components retained: %d", evecs.Rows())
+	}
+}
+
+func TestPCACompute(t *testing.T) {
+	data := newPCATestData()
+	defer data.Close()
+
+	mean := NewMat()
+	defer mean.Close()
+	evecs := NewMat()
+	defer evecs.Close()
+	evals := NewMat()
+	defer evals.Close()
+
+	PCACompute(data, &mean, &evecs, &evals, 0)
+	if mean.Empty() || evecs.Empty() || evals.Empty() {
+		t.Fatal("PCACompute should not return empty results")
+	}
+	if evecs.Rows() != 2 {
+		t.Errorf("invalid number of components: %d", evecs.Rows())
+	}
+
+	projected := NewMat()
+	defer projected.Close()
+	PCAProject(data, mean, evecs, &projected)
+	if projected.Rows() != 5 || projected.Cols() != 2 {
+		t.Fatalf("invalid projection size: %dx%d", projected.Rows(), projected.Cols())
+	}
+
+	reconstructed := NewMat()
+	defer reconstructed.Close()
+	PCABackProject(projected, mean, evecs, &reconstructed)
+	if math.Abs(float64(reconstructed.GetFloatAt(3, 0)-3)) > 1e-4 {
+		t.Errorf("invalid reconstruction: %v", reconstructed.GetFloatAt(3, 0))
+	}
+
+	mean2 := NewMat()
+	defer mean2.Close()
+	evecs2 := NewMat()
+	defer evecs2.Close()
+	evals2 := NewMat()
+	defer evals2.Close()
+
+	PCAComputeWithRetainedVariance(data, &mean2, &evecs2, &evals2, 0.9)
+	if evecs2.Rows() != 1 {
+		t.Errorf("invalid number of components retained: %d", evecs2.Rows())
+	}
+}
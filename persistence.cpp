@@ -0,0 +1,211 @@
+#include "persistence.h"
+#include <string.h>
+
+// FileStorage
+
+FileStorage FileStorage_New() {
+    return new cv::FileStorage();
+}
+
+FileStorage FileStorage_NewWithParams(const char* filename, int flags, const char* encoding) {
+    return new cv::FileStorage(filename, flags, encoding);
+}
+
+void FileStorage_Close(FileStorage fs) {
+    delete fs;
+}
+
+bool FileStorage_Open(FileStorage fs, const char* filename, int flags, const char* encoding) {
+    return fs->open(filename, flags, encoding);
+}
+
+bool FileStorage_IsOpened(FileStorage fs) {
+    return fs->isOpened();
+}
+
+void FileStorage_Release(FileStorage fs) {
+    fs->release();
+}
+
+const char* FileStorage_ReleaseAndGetString(FileStorage fs) {
+    cv::String s = fs->releaseAndGetString();
+    return strdup(s.c_str());
+}
+
+const char* FileStorage_ElementName(FileStorage fs) {
+    return strdup(fs->elname.c_str());
+}
+
+int FileStorage_State(FileStorage fs) {
+    return fs->state;
+}
+
+int FileStorage_GetFormat(FileStorage fs) {
+    return fs->getFormat();
+}
+
+void FileStorage_StartWriteStruct(FileStorage fs, const char* name, int flags, const char* typeName) {
+    fs->startWriteStruct(name, flags, typeName);
+}
+
+void FileStorage_EndWriteStruct(FileStorage fs) {
+    fs->endWriteStruct();
+}
+
+void FileStorage_WriteMat(FileStorage fs, const char* name, Mat val) {
+    fs->write(name, *val);
+}
+
+void FileStorage_WriteMats(FileStorage fs, const char* name, struct Mats val) {
+    std::vector<cv::Mat> mats;
+
+    for (int i = 0; i < val.length; ++i) {
+        mats.push_back(*val.mats[i]);
+    }
+
+    cv::write(*fs, name, mats);
+}
+
+void FileStorage_WriteString(FileStorage fs, const char* name, const char* val) {
+    fs->write(name, cv::String(val));
+}
+
+void FileStorage_WriteStringArray(FileStorage fs, const char* name, struct CStrings val) {
+    std::vector<cv::String> vals;
+
+    for (int i = 0; i < val.length; ++i) {
+        vals.push_back(val.strs[i]);
+    }
+
+    fs->write(name, vals);
+}
+
+void FileStorage_WriteDouble(FileStorage fs, const char* name, double val) {
+    fs->write(name, val);
+}
+
+void FileStorage_WriteInt(FileStorage fs, const char* name, int val) {
+    fs->write(name, val);
+}
+
+void FileStorage_WriteComment(FileStorage fs, const char* comment, bool append) {
+    fs->writeComment(comment, append);
+}
+
+void FileStorage_WriteRaw(FileStorage fs, const char* fmt, struct ByteArray vec) {
+    fs->writeRaw(fmt, vec.data, vec.length);
+}
+
+FileNode FileStorage_GetFirstTopLevelNode(FileStorage fs) {
+    return new cv::FileNode(fs->getFirstTopLevelNode());
+}
+
+FileNode FileStorage_GetNode(FileStorage fs, const char* nodename) {
+    return new cv::FileNode((*fs)[nodename]);
+}
+
+FileNode FileStorage_Root(FileStorage fs, int streamidx) {
+    return new cv::FileNode(fs->root(streamidx));
+}
+
+// FileNode
+
+void FileNode_Close(FileNode fn) {
+    delete fn;
+}
+
+bool FileNode_Empty(FileNode fn) {
+    return fn->empty();
+}
+
+bool FileNode_IsInt(FileNode fn) {
+    return fn->isInt();
+}
+
+bool FileNode_IsMap(FileNode fn) {
+    return fn->isMap();
+}
+
+bool FileNode_IsNamed(FileNode fn) {
+    return fn->isNamed();
+}
+
+bool FileNode_IsNone(FileNode fn) {
+    return fn->isNone();
+}
+
+bool FileNode_IsReal(FileNode fn) {
+    return fn->isReal();
+}
+
+bool FileNode_IsSeq(FileNode fn) {
+    return fn->isSeq();
+}
+
+bool FileNode_IsString(FileNode fn) {
+    return fn->isString();
+}
+
+int FileNode_Type(FileNode fn) {
+    return fn->type();
+}
+
+const char* FileNode_Name(FileNode fn) {
+    return strdup(fn->name().c_str());
+}
+
+int FileNode_Size(FileNode fn) {
+    return (int)fn->size();
+}
+
+void FileNode_Keys(FileNode fn, CStrings* keys) {
+    std::vector<cv::String> names = fn->keys();
+    const char **strs = new const char*[names.size()];
+
+    for (size_t i = 0; i < names.size(); ++i) {
+        char* s = new char[names[i].size() + 1];
+        strcpy(s, names[i].c_str());
+        strs[i] = s;
+    }
+
+    keys->length = (int)names.size();
+    keys->strs = strs;
+}
+
+FileNode FileNode_Get(FileNode fn, const char* nodename) {
+    return new cv::FileNode((*fn)[nodename]);
+}
+
+FileNode FileNode_At(FileNode fn, int i) {
+    return new cv::FileNode((*fn)[i]);
+}
+
+int FileNode_Int(FileNode fn) {
+    return (int)(*fn);
+}
+
+double FileNode_Double(FileNode fn) {
+    return fn->real();
+}
+
+const char* FileNode_String(FileNode fn) {
+    return strdup(fn->string().c_str());
+}
+
+Mat FileNode_Mat(FileNode fn) {
+    cv::Mat* m = new cv::Mat();
+    cv::read(*fn, *m);
+    return m;
+}
+
+void FileNode_Mats(FileNode fn, struct Mats* mats) {
+    std::vector<cv::Mat> vals;
+    cv::read(*fn, vals);
+    mats->mats = new Mat[vals.size()];
+
+    for (size_t i = 0; i < vals.size(); ++i) {
+        mats->mats[i] = new cv::Mat(vals[i]);
+    }
+
+    mats->length = (int)vals.size();
+}
@@ -0,0 +1,485 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "persistence.h"
+*/
+import "C"
+import (
+	"unsafe"
+)
+
+// FileStorageMode is the operation mode and format of a FileStorage.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+type FileStorageMode int
+
+const (
+	// FileStorageModeRead opens the file for reading.
+	FileStorageModeRead FileStorageMode = 0
+
+	// FileStorageModeWrite opens the file for writing.
+	FileStorageModeWrite FileStorageMode = 1
+
+	// FileStorageModeAppend opens the file for appending.
+	FileStorageModeAppend FileStorageMode = 2
+
+	// FileStorageModeMemory reads data from, or writes data to, the filename
+	// parameter itself instead of a file on disk.
+	FileStorageModeMemory FileStorageMode = 4
+
+	// FileStorageModeFormatMask is the mask for the format flags.
+	FileStorageModeFormatMask FileStorageMode = 7 << 3
+
+	// FileStorageModeFormatAuto detects the format from the file extension.
+	FileStorageModeFormatAuto FileStorageMode = 0
+
+	// FileStorageModeFormatXML uses the XML format.
+	FileStorageModeFormatXML FileStorageMode = 1 << 3
+
+	// FileStorageModeFormatYAML uses the YAML format.
+	FileStorageModeFormatYAML FileStorageMode = 2 << 3
+
+	// FileStorageModeFormatJSON uses the JSON format.
+	FileStorageModeFormatJSON FileStorageMode = 3 << 3
+
+	// FileStorageModeBase64 writes raw data in Base64 by default.
+	FileStorageModeBase64 FileStorageMode = 64
+
+	// FileStorageModeWriteBase64 is a shortcut for FileStorageModeBase64|FileStorageModeWrite.
+	FileStorageModeWriteBase64 FileStorageMode = FileStorageModeBase64 | FileStorageModeWrite
+)
+
+// FileStorageState is the writing state of a FileStorage.
+type FileStorageState int
+
+const (
+	// FileStorageStateUndefined is the initial state.
+	FileStorageStateUndefined FileStorageState = 0
+
+	// FileStorageStateValueExpected means a value is expected next.
+	FileStorageStateValueExpected FileStorageState = 1
+
+	// FileStorageStateNameExpected means a name is expected next.
+	FileStorageStateNameExpected FileStorageState = 2
+
+	// FileStorageStateInsideMap means the storage is writing inside a mapping.
+	FileStorageStateInsideMap FileStorageState = 4
+)
+
+// FileNodeType is the type of a FileNode, also used as the flags parameter
+// of FileStorage.StartWriteStruct.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/de/dd9/classcv_1_1FileNode.html
+//
+type FileNodeType int
+
+const (
+	// FileNodeTypeNone is an empty node.
+	FileNodeTypeNone FileNodeType = 0
+
+	// FileNodeTypeInt is an integer.
+	FileNodeTypeInt FileNodeType = 1
+
+	// FileNodeTypeReal is a floating-point number.
+	FileNodeTypeReal FileNodeType = 2
+
+	// FileNodeTypeFloat is a synonym for FileNodeTypeReal.
+	FileNodeTypeFloat FileNodeType = FileNodeTypeReal
+
+	// FileNodeTypeStr is a text string in UTF-8 encoding.
+	FileNodeTypeStr FileNodeType = 3
+
+	// FileNodeTypeString is a synonym for FileNodeTypeStr.
+	FileNodeTypeString FileNodeType = FileNodeTypeStr
+
+	// FileNodeTypeSeq is a sequence.
+	FileNodeTypeSeq FileNodeType = 4
+
+	// FileNodeTypeMap is a mapping.
+	FileNodeTypeMap FileNodeType = 5
+
+	// FileNodeTypeTypeMask is the mask for the node type.
+	FileNodeTypeTypeMask FileNodeType = 7
+
+	// FileNodeTypeFlow is a compact representation of a sequence or mapping.
+	// Used only by the YAML writer.
+	FileNodeTypeFlow FileNodeType = 8
+
+	// FileNodeTypeUniform is used only when reading FileStorage.
+	// If set, means that all the collection elements are numbers of the same type.
+	FileNodeTypeUniform FileNodeType = 8
+
+	// FileNodeTypeEmpty means an empty structure (sequence or mapping).
+	FileNodeTypeEmpty FileNodeType = 16
+
+	// FileNodeTypeNamed means the node has a name (i.e. it is an element of a mapping).
+	FileNodeTypeNamed FileNodeType = 32
+)
+
+// FileStorage is a wrapper around cv::FileStorage, used to read and write
+// XML, YAML and JSON files in the same format as OpenCV itself.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+type FileStorage struct {
+	p C.FileStorage
+}
+
+// NewFileStorage returns a new FileStorage that is not yet opened.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+func NewFileStorage() FileStorage {
+	return FileStorage{p: C.FileStorage_New()}
+}
+
+// NewFileStorageWithParams returns a new FileStorage and opens filename with
+// the specified flags. If FileStorageModeMemory is set, filename is the
+// content to read, or a name whose extension selects the output format.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+func NewFileStorageWithParams(filename string, flags FileStorageMode, encoding string) FileStorage {
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	cEncoding := C.CString(encoding)
+	defer C.free(unsafe.Pointer(cEncoding))
+
+	return FileStorage{p: C.FileStorage_NewWithParams(cFilename, C.int(flags), cEncoding)}
+}
+
+// Close releases the FileStorage and deletes its pointer.
+func (fs *FileStorage) Close() error {
+	C.FileStorage_Close(fs.p)
+	fs.p = nil
+	return nil
+}
+
+// Open opens a file, closing any file that was previously opened.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+func (fs *FileStorage) Open(filename string, flags FileStorageMode, encoding string) bool {
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	cEncoding := C.CString(encoding)
+	defer C.free(unsafe.Pointer(cEncoding))
+
+	return bool(C.FileStorage_Open(fs.p, cFilename, C.int(flags), cEncoding))
+}
+
+// IsOpened checks whether the file is opened.
+func (fs *FileStorage) IsOpened() bool {
+	return bool(C.FileStorage_IsOpened(fs.p))
+}
+
+// Release closes the file and releases all the memory buffers.
+// Any further operations on the FileStorage require Open to be called again.
+func (fs *FileStorage) Release() {
+	C.FileStorage_Release(fs.p)
+}
+
+// ReleaseAndGetString closes the file and returns the written content.
+// This is only useful when writing with FileStorageModeMemory.
+func (fs *FileStorage) ReleaseAndGetString() string {
+	c := C.FileStorage_ReleaseAndGetString(fs.p)
+	defer C.free(unsafe.Pointer(c))
+	return C.GoString(c)
+}
+
+// ElementName returns the name of the element currently being written.
+func (fs *FileStorage) ElementName() string {
+	c := C.FileStorage_ElementName(fs.p)
+	defer C.free(unsafe.Pointer(c))
+	return C.GoString(c)
+}
+
+// State returns the current writing state of the FileStorage.
+func (fs *FileStorage) State() FileStorageState {
+	return FileStorageState(C.FileStorage_State(fs.p))
+}
+
+// GetFormat returns the current format, one of FileStorageModeFormatXML,
+// FileStorageModeFormatYAML or FileStorageModeFormatJSON.
+func (fs *FileStorage) GetFormat() FileStorageMode {
+	return FileStorageMode(C.FileStorage_GetFormat(fs.p))
+}
+
+// StartWriteStruct starts writing a nested structure (a sequence or a mapping).
+// Every call must be matched by a call to EndWriteStruct.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+func (fs *FileStorage) StartWriteStruct(name string, flags FileNodeType, typeName string) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cTypeName := C.CString(typeName)
+	defer C.free(unsafe.Pointer(cTypeName))
+
+	C.FileStorage_StartWriteStruct(fs.p, cName, C.int(flags), cTypeName)
+}
+
+// EndWriteStruct finishes writing the structure started with StartWriteStruct.
+func (fs *FileStorage) EndWriteStruct() {
+	C.FileStorage_EndWriteStruct(fs.p)
+}
+
+// WriteMat writes a Mat with the given name.
+func (fs *FileStorage) WriteMat(name string, mat Mat) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	C.FileStorage_WriteMat(fs.p, cName, mat.p)
+}
+
+// WriteMats writes a sequence of Mats with the given name.
+func (fs *FileStorage) WriteMats(name string, mats []Mat) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cMats := C.struct_Mats{length: C.int(len(mats))}
+	if len(mats) > 0 {
+		cMatArray := make([]C.Mat, len(mats))
+		for i, m := range mats {
+			cMatArray[i] = m.p
+		}
+		cMats.mats = (*C.Mat)(&cMatArray[0])
+	}
+
+	C.FileStorage_WriteMats(fs.p, cName, cMats)
+}
+
+// WriteString writes a string with the given name.
+func (fs *FileStorage) WriteString(name string, val string) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	cVal := C.CString(val)
+	defer C.free(unsafe.Pointer(cVal))
+
+	C.FileStorage_WriteString(fs.p, cName, cVal)
+}
+
+// WriteStringArray writes a sequence of strings with the given name.
+func (fs *FileStorage) WriteStringArray(name string, val []string) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cVal := C.struct_CStrings{length: C.int(len(val))}
+	if len(val) > 0 {
+		cStrs := make([]*C.char, len(val))
+		for i, s := range val {
+			cStrs[i] = C.CString(s)
+			defer C.free(unsafe.Pointer(cStrs[i]))
+		}
+		cVal.strs = (**C.char)(&cStrs[0])
+	}
+
+	C.FileStorage_WriteStringArray(fs.p, cName, cVal)
+}
+
+// WriteDouble writes a floating-point number with the given name.
+func (fs *FileStorage) WriteDouble(name string, val float64) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	C.FileStorage_WriteDouble(fs.p, cName, C.double(val))
+}
+
+// WriteInt writes an integer with the given name.
+func (fs *FileStorage) WriteInt(name string, val int) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	C.FileStorage_WriteInt(fs.p, cName, C.int(val))
+}
+
+// WriteComment writes a comment. If append is true, the comment is put on the
+// same line as the previous element, if possible.
+func (fs *FileStorage) WriteComment(comment string, append bool) {
+	cComment := C.CString(comment)
+	defer C.free(unsafe.Pointer(cComment))
+
+	C.FileStorage_WriteComment(fs.p, cComment, C.bool(append))
+}
+
+// WriteRaw writes multiple numbers in a compact form. The fmt parameter
+// describes the element layout, for example "u" for uint8 or "2if" for
+// pairs of int32 followed by a float32.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/da/d56/classcv_1_1FileStorage.html
+//
+func (fs *FileStorage) WriteRaw(fmt string, vec []byte) error {
+	cFmt := C.CString(fmt)
+	defer C.free(unsafe.Pointer(cFmt))
+
+	cVec, err := toByteArray(vec)
+	if err != nil {
+		return err
+	}
+
+	C.FileStorage_WriteRaw(fs.p, cFmt, *cVec)
+	return nil
+}
+
+// GetFirstTopLevelNode returns the first element of the top-level mapping.
+// The returned FileNode must be closed when no longer needed.
+func (fs *FileStorage) GetFirstTopLevelNode() FileNode {
+	return FileNode{p: C.FileStorage_GetFirstTopLevelNode(fs.p)}
+}
+
+// GetNode returns the top-level mapping element with the given name.
+// The returned FileNode must be closed when no longer needed.
+func (fs *FileStorage) GetNode(name string) FileNode {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return FileNode{p: C.FileStorage_GetNode(fs.p, cName)}
+}
+
+// Root returns the top-level node of the given stream.
+// The returned FileNode must be closed when no longer needed.
+func (fs *FileStorage) Root(streamIdx int) FileNode {
+	return FileNode{p: C.FileStorage_Root(fs.p, C.int(streamIdx))}
+}
+
+// FileNode is a wrapper around cv::FileNode, an element of a file read
+// by a FileStorage. A FileNode is only valid while the FileStorage it was
+// read from is open.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/de/dd9/classcv_1_1FileNode.html
+//
+type FileNode struct {
+	p C.FileNode
+}
+
+// Close deletes the FileNode's pointer.
+func (fn *FileNode) Close() error {
+	C.FileNode_Close(fn.p)
+	fn.p = nil
+	return nil
+}
+
+// Empty returns true if the node is empty.
+func (fn *FileNode) Empty() bool {
+	return bool(C.FileNode_Empty(fn.p))
+}
+
+// IsInt returns true if the node is an integer.
+func (fn *FileNode) IsInt() bool {
+	return bool(C.FileNode_IsInt(fn.p))
+}
+
+// IsMap returns true if the node is a mapping.
+func (fn *FileNode) IsMap() bool {
+	return bool(C.FileNode_IsMap(fn.p))
+}
+
+// IsNamed returns true if the node has a name.
+func (fn *FileNode) IsNamed() bool {
+	return bool(C.FileNode_IsNamed(fn.p))
+}
+
+// IsNone returns true if the node is a "none" object.
+func (fn *FileNode) IsNone() bool {
+	return bool(C.FileNode_IsNone(fn.p))
+}
+
+// IsReal returns true if the node is a floating-point number.
+func (fn *FileNode) IsReal() bool {
+	return bool(C.FileNode_IsReal(fn.p))
+}
+
+// IsSeq returns true if the node is a sequence.
+func (fn *FileNode) IsSeq() bool {
+	return bool(C.FileNode_IsSeq(fn.p))
+}
+
+// IsString returns true if the node is a text string.
+func (fn *FileNode) IsString() bool {
+	return bool(C.FileNode_IsString(fn.p))
+}
+
+// Type returns the type of the node.
+func (fn *FileNode) Type() FileNodeType {
+	return FileNodeType(C.FileNode_Type(fn.p))
+}
+
+// Name returns the name of the node, or an empty string if it has no name.
+func (fn *FileNode) Name() string {
+	c := C.FileNode_Name(fn.p)
+	defer C.free(unsafe.Pointer(c))
+	return C.GoString(c)
+}
+
+// Size returns the number of elements in a sequence or mapping, 1 for a
+// scalar node, and 0 for an empty one.
+func (fn *FileNode) Size() int {
+	return int(C.FileNode_Size(fn.p))
+}
+
+// Keys returns the names of all the elements of a mapping.
+func (fn *FileNode) Keys() []string {
+	cStrs := C.CStrings{}
+	C.FileNode_Keys(fn.p, &cStrs)
+	defer C.CStrings_Close(cStrs)
+
+	return toGoStrings(cStrs)
+}
+
+// Get returns the element of a mapping with the given name.
+// The returned FileNode must be closed when no longer needed.
+func (fn *FileNode) Get(name string) FileNode {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return FileNode{p: C.FileNode_Get(fn.p, cName)}
+}
+
+// At returns the element of a sequence at index i.
+// The returned FileNode must be closed when no longer needed.
+func (fn *FileNode) At(i int) FileNode {
+	return FileNode{p: C.FileNode_At(fn.p, C.int(i))}
+}
+
+// Int returns the node value as an int.
+func (fn *FileNode) Int() int {
+	return int(C.FileNode_Int(fn.p))
+}
+
+// Double returns the node value as a float64.
+func (fn *FileNode) Double() float64 {
+	return float64(C.FileNode_Double(fn.p))
+}
+
+// String returns the node value as a string, or an empty string if the
+// node is not a text string.
+func (fn *FileNode) String() string {
+	c := C.FileNode_String(fn.p)
+	defer C.free(unsafe.Pointer(c))
+	return C.GoString(c)
+}
+
+// Mat returns the node value as a Mat. The Mat is empty if the node does
+// not hold a matrix.
+func (fn *FileNode) Mat() Mat {
+	return newMat(C.FileNode_Mat(fn.p))
+}
+
+// Mats returns the node value as a slice of Mats, as written by
+// FileStorage.WriteMats. Returned Mats should be closed manually.
+func (fn *FileNode) Mats() (mats []Mat) {
+	cMats := C.struct_Mats{}
+	C.FileNode_Mats(fn.p, &cMats)
+	return toGoMats(cMats)
+}
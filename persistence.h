@@ -0,0 +1,72 @@
+#ifndef _OPENCV3_PERSISTENCE_H_
+#define _OPENCV3_PERSISTENCE_H_
+
+#include <stdbool.h>
+
+#ifdef __cplusplus
+#include <opencv2/opencv.hpp>
+extern "C" {
+#endif
+
+#include "core.h"
+
+#ifdef __cplusplus
+typedef cv::FileStorage* FileStorage;
+typedef cv::FileNode* FileNode;
+#else
+typedef void* FileStorage;
+typedef void* FileNode;
+#endif
+
+// FileStorage
+FileStorage FileStorage_New();
+FileStorage FileStorage_NewWithParams(const char* filename, int flags, const char* encoding);
+void FileStorage_Close(FileStorage fs);
+bool FileStorage_Open(FileStorage fs, const char* filename, int flags, const char* encoding);
+bool FileStorage_IsOpened(FileStorage fs);
+void FileStorage_Release(FileStorage fs);
+const char* FileStorage_ReleaseAndGetString(FileStorage fs);
+const char* FileStorage_ElementName(FileStorage fs);
+int FileStorage_State(FileStorage fs);
+int FileStorage_GetFormat(FileStorage fs);
+void FileStorage_StartWriteStruct(FileStorage fs, const char* name, int flags, const char* typeName);
+void FileStorage_EndWriteStruct(FileStorage fs);
+void FileStorage_WriteMat(FileStorage fs, const char* name, Mat val);
+void FileStorage_WriteMats(FileStorage fs, const char* name, struct Mats val);
+void FileStorage_WriteString(FileStorage fs, const char* name, const char* val);
+void FileStorage_WriteStringArray(FileStorage fs, const char* name, struct CStrings val);
+void FileStorage_WriteDouble(FileStorage fs, const char* name, double val);
+void FileStorage_WriteInt(FileStorage fs, const char* name, int val);
+void FileStorage_WriteComment(FileStorage fs, const char* comment, bool append);
+void FileStorage_WriteRaw(FileStorage fs, const char* fmt, struct ByteArray vec);
+FileNode FileStorage_GetFirstTopLevelNode(FileStorage fs);
+FileNode FileStorage_GetNode(FileStorage fs, const char* nodename);
+FileNode FileStorage_Root(FileStorage fs, int streamidx);
+
+// FileNode
+void FileNode_Close(FileNode fn);
+bool FileNode_Empty(FileNode fn);
+bool FileNode_IsInt(FileNode fn);
+bool FileNode_IsMap(FileNode fn);
+bool FileNode_IsNamed(FileNode fn);
+bool FileNode_IsNone(FileNode fn);
+bool FileNode_IsReal(FileNode fn);
+bool FileNode_IsSeq(FileNode fn);
+bool FileNode_IsString(FileNode fn);
+int FileNode_Type(FileNode fn);
+const char* FileNode_Name(FileNode fn);
+int FileNode_Size(FileNode fn);
+void FileNode_Keys(FileNode fn, CStrings* keys);
+FileNode FileNode_Get(FileNode fn, const char* nodename);
+FileNode FileNode_At(FileNode fn, int i);
+int FileNode_Int(FileNode fn);
+double FileNode_Double(FileNode fn);
+const char* FileNode_String(FileNode fn);
+Mat FileNode_Mat(FileNode fn);
+void FileNode_Mats(FileNode fn, struct Mats* mats);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif //_OPENCV3_PERSISTENCE_H_
@@ -0,0 +1,167 @@
+package gocv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileStorageWriteRead(t *testing.T) {
+	for _, name := range []string{"test.yml", "test.xml", "test.json"} {
+		t.Run(name, func(t *testing.T) {
+			fs := NewFileStorageWithParams(name, FileStorageModeWrite|FileStorageModeMemory, "")
+			defer fs.Close()
+
+			if !fs.IsOpened() {
+				t.Fatal("FileStorage should be opened")
+			}
+
+			mat := Eye(3, 3, MatTypeCV64F)
+			defer mat.Close()
+
+			fs.WriteMat("camera_matrix", mat)
+			fs.WriteInt("count", 42)
+			fs.WriteDouble("error", 0.25)
+			fs.WriteString("name", "gocv")
+			fs.WriteStringArray("labels", []string{"a", "b", "c"})
+
+			fs.StartWriteStruct("nested", FileNodeTypeMap, "")
+			fs.WriteInt("width", 640)
+			fs.WriteInt("height", 480)
+			fs.EndWriteStruct()
+
+			content := fs.ReleaseAndGetString()
+			if content == "" {
+				t.Fatal("ReleaseAndGetString should not be empty")
+			}
+
+			rfs := NewFileStorageWithParams(content, FileStorageModeRead|FileStorageModeMemory, "")
+			defer rfs.Close()
+
+			if !rfs.IsOpened() {
+				t.Fatal("FileStorage should be opened for reading")
+			}
+
+			node := rfs.GetNode("camera_matrix")
+			defer node.Close()
+			if !node.IsMap() {
+				t.Errorf("camera_matrix should be a map, got %v", node.Type())
+			}
+
+			res := node.Mat()
+			defer res.Close()
+			if res.Rows() != 3 || res.Cols() != 3 || res.Type() != MatTypeCV64F {
+				t.Fatalf("invalid Mat read: %dx%d %v", res.Rows(), res.Cols(), res.Type())
+			}
+			if res.GetDoubleAt(1, 1) != 1.0 || res.GetDoubleAt(0, 1) != 0.0 {
+				t.Error("invalid Mat content read")
+			}
+
+			count := rfs.GetNode("count")
+			defer count.Close()
+			if !count.IsInt() || count.Int() != 42 {
+				t.Errorf("invalid int read: %v", count.Int())
+			}
+
+			e := rfs.GetNode("error")
+			defer e.Close()
+			if !e.IsReal() || e.Double() != 0.25 {
+				t.Errorf("invalid double read: %v", e.Double())
+			}
+
+			n := rfs.GetNode("name")
+			defer n.Close()
+			if !n.IsString() || n.String() != "gocv" {
+				t.Errorf("invalid string read: %v", n.String())
+			}
+
+			labels := rfs.GetNode("labels")
+			defer labels.Close()
+			if !labels.IsSeq() || labels.Size() != 3 {
+				t.Fatalf("invalid sequence read: size %v", labels.Size())
+			}
+			last := labels.At(2)
+			defer last.Close()
+			if last.String() != "c" {
+				t.Errorf("invalid sequence element read: %v", last.String())
+			}
+
+			nested := rfs.GetNode("nested")
+			defer nested.Close()
+			keys := nested.Keys()
+			if len(keys) != 2 || keys[0] != "width" || keys[1] != "height" {
+				t.Errorf("invalid keys read: %v", keys)
+			}
+			width := nested.Get("width")
+			defer width.Close()
+			if width.Int() != 640 || width.Name() != "width" {
+				t.Errorf("invalid nested value read: %v %v", width.Name(), width.Int())
+			}
+
+			missing := rfs.GetNode("missing")
+			defer missing.Close()
+			if !missing.Empty() {
+				t.Error("missing node should be empty")
+			}
+		})
+	}
+}
+
+func TestFileStorageMats(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "gocvtests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+	filename := filepath.Join(tmpdir, "mats.yml")
+
+	fs := NewFileStorage()
+	defer fs.Close()
+
+	if !fs.Open(filename, FileStorageModeWrite, "") {
+		t.Fatal("unable to open FileStorage for writing")
+	}
+	if fs.GetFormat() != FileStorageModeFormatYAML {
+		t.Errorf("invalid format: %v", fs.GetFormat())
+	}
+
+	m1 := Ones(2, 2, MatTypeCV32F)
+	defer m1.Close()
+	m2 := Zeros(3, 1, MatTypeCV8U)
+	defer m2.Close()
+
+	fs.WriteComment("a list of mats", false)
+	fs.WriteMats("mats", []Mat{m1, m2})
+	fs.Release()
+
+	if !fs.Open(filename, FileStorageModeRead, "") {
+		t.Fatal("unable to open FileStorage for reading")
+	}
+
+	node := fs.GetFirstTopLevelNode()
+	defer node.Close()
+	if node.Name() != "mats" {
+		t.Errorf("invalid first top level node: %v", node.Name())
+	}
+
+	mats := node.Mats()
+	if len(mats) != 2 {
+		t.Fatalf("invalid number of Mats read: %v", len(mats))
+	}
+	defer mats[0].Close()
+	defer mats[1].Close()
+
+	if mats[0].Rows() != 2 || mats[0].Type() != MatTypeCV32F || mats[0].GetFloatAt(1, 1) != 1.0 {
+		t.Error("invalid first Mat read")
+	}
+	if mats[1].Rows() != 3 || mats[1].Cols() != 1 || mats[1].Type() != MatTypeCV8U {
+		t.Error("invalid second Mat read")
+	}
+
+	root := fs.Root(0)
+	defer root.Close()
+	if !root.IsMap() || root.Size() != 1 {
+		t.Errorf("invalid root node: %v", root.Type())
+	}
+}
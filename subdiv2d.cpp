@@ -0,0 +1,128 @@
+#include "subdiv2d.h"
+
+Subdiv2D Subdiv2D_New() {
+    return new cv::Subdiv2D();
+}
+
+Subdiv2D Subdiv2D_NewWithRect(Rect rect) {
+    return new cv::Subdiv2D(cv::Rect(rect.x, rect.y, rect.width, rect.height));
+}
+
+void Subdiv2D_Close(Subdiv2D sd) {
+    delete sd;
+}
+
+void Subdiv2D_InitDelaunay(Subdiv2D sd, Rect rect) {
+    sd->initDelaunay(cv::Rect(rect.x, rect.y, rect.width, rect.height));
+}
+
+int Subdiv2D_Insert(Subdiv2D sd, Point2f pt) {
+    return sd->insert(cv::Point2f(pt.x, pt.y));
+}
+
+void Subdiv2D_InsertMultiple(Subdiv2D sd, Point2fVector pts) {
+    sd->insert(*pts);
+}
+
+int Subdiv2D_Locate(Subdiv2D sd, Point2f pt, int* edge, int* vertex) {
+    return sd->locate(cv::Point2f(pt.x, pt.y), *edge, *vertex);
+}
+
+int Subdiv2D_FindNearest(Subdiv2D sd, Point2f pt, Point2f* nearestPt) {
+    cv::Point2f p;
+    int vertex = sd->findNearest(cv::Point2f(pt.x, pt.y), &p);
+    nearestPt->x = p.x;
+    nearestPt->y = p.y;
+    return vertex;
+}
+
+void Subdiv2D_GetEdgeList(Subdiv2D sd, Point2fVector edgeList) {
+    std::vector<cv::Vec4f> edges;
+    sd->getEdgeList(edges);
+
+    edgeList->clear();
+    for (size_t i = 0; i < edges.size(); i++) {
+        edgeList->push_back(cv::Point2f(edges[i][0], edges[i][1]));
+        edgeList->push_back(cv::Point2f(edges[i][2], edges[i][3]));
+    }
+}
+
+void Subdiv2D_GetLeadingEdgeList(Subdiv2D sd, IntVector* leadingEdgeList) {
+    std::vector<int> edges;
+    sd->getLeadingEdgeList(edges);
+
+    int* ids = new int[edges.size()];
+    for (size_t i = 0; i < edges.size(); i++) {
+        ids[i] = edges[i];
+    }
+
+    leadingEdgeList->length = edges.size();
+    leadingEdgeList->val = ids;
+}
+
+void Subdiv2D_GetTriangleList(Subdiv2D sd, Point2fVector triangleList) {
+    std::vector<cv::Vec6f> triangles;
+    sd->getTriangleList(triangles);
+
+    triangleList->clear();
+    for (size_t i = 0; i < triangles.size(); i++) {
+        triangleList->push_back(cv::Point2f(triangles[i][0], triangles[i][1]));
+        triangleList->push_back(cv::Point2f(triangles[i][2], triangles[i][3]));
+        triangleList->push_back(cv::Point2f(triangles[i][4], triangles[i][5]));
+    }
+}
+
+void Subdiv2D_GetVoronoiFacetList(Subdiv2D sd, IntVector idx, Point2fVector facetPoints, IntVector* facetLengths,
+    Point2fVector facetCenters) {
+    std::vector<int> ids(idx.val, idx.val + idx.length);
+    std::vector<std::vector<cv::Point2f> > facets;
+    sd->getVoronoiFacetList(ids, facets, *facetCenters);
+
+    int* lengths = new int[facets.size()];
+    facetPoints->clear();
+    for (size_t i = 0; i < facets.size(); i++) {
+        lengths[i] = facets[i].size();
+        facetPoints->insert(facetPoints->end(), facets[i].begin(), facets[i].end());
+    }
+
+    facetLengths->length = facets.size();
+    facetLengths->val = lengths;
+}
+
+Point2f Subdiv2D_GetVertex(Subdiv2D sd, int vertex, int* firstEdge) {
+    cv::Point2f p = sd->getVertex(vertex, firstEdge);
+    Point2f pt = {p.x, p.y};
+    return pt;
+}
+
+int Subdiv2D_GetEdge(Subdiv2D sd, int edge, int nextEdgeType) {
+    return sd->getEdge(edge, nextEdgeType);
+}
+
+int Subdiv2D_NextEdge(Subdiv2D sd, int edge) {
+    return sd->nextEdge(edge);
+}
+
+int Subdiv2D_RotateEdge(Subdiv2D sd, int edge, int rotate) {
+    return sd->rotateEdge(edge, rotate);
+}
+
+int Subdiv2D_SymEdge(Subdiv2D sd, int edge) {
+    return sd->symEdge(edge);
+}
+
+int Subdiv2D_EdgeOrg(Subdiv2D sd, int edge, Point2f* orgPt) {
+    cv::Point2f p;
+    int vertex = sd->edgeOrg(edge, &p);
+    orgPt->x = p.x;
+    orgPt->y = p.y;
+    return vertex;
+}
+
+int Subdiv2D_EdgeDst(Subdiv2D sd, int edge, Point2f* dstPt) {
+    cv::Point2f p;
+    int vertex = sd->edgeDst(edge, &p);
+    dstPt->x = p.x;
+    dstPt->y = p.y;
+    return vertex;
+}
@@ -0,0 +1,314 @@
+package gocv
+
+/*
+#include <stdlib.h>
+#include "subdiv2d.h"
+*/
+import "C"
+import "image"
+
+// Subdiv2DPointLocationType is the location of a point in a Subdiv2D, as
+// returned by Locate.
+type Subdiv2DPointLocationType int
+
+const (
+	// PtlocError indicates that the point is invalid, for example NaN.
+	PtlocError Subdiv2DPointLocationType = -2
+
+	// PtlocOutsideRect indicates that the point is outside the subdivision
+	// bounding rectangle.
+	PtlocOutsideRect Subdiv2DPointLocationType = -1
+
+	// PtlocInside indicates that the point is inside a facet.
+	PtlocInside Subdiv2DPointLocationType = 0
+
+	// PtlocVertex indicates that the point coincides with a vertex.
+	PtlocVertex Subdiv2DPointLocationType = 1
+
+	// PtlocOnEdge indicates that the point is on an edge.
+	PtlocOnEdge Subdiv2DPointLocationType = 2
+)
+
+// Subdiv2DEdgeType is the type of the edge returned by GetEdge, relative to
+// the input edge.
+type Subdiv2DEdgeType int
+
+const (
+	// NextAroundOrg is the next edge around the edge origin.
+	NextAroundOrg Subdiv2DEdgeType = 0x00
+
+	// NextAroundDst is the next edge around the edge destination.
+	NextAroundDst Subdiv2DEdgeType = 0x22
+
+	// PrevAroundOrg is the previous edge around the edge origin.
+	PrevAroundOrg Subdiv2DEdgeType = 0x11
+
+	// PrevAroundDst is the previous edge around the edge destination.
+	PrevAroundDst Subdiv2DEdgeType = 0x33
+
+	// NextAroundLeft is the next edge around the left facet.
+	NextAroundLeft Subdiv2DEdgeType = 0x13
+
+	// NextAroundRight is the next edge around the right facet.
+	NextAroundRight Subdiv2DEdgeType = 0x31
+
+	// PrevAroundLeft is the previous edge around the left facet.
+	PrevAroundLeft Subdiv2DEdgeType = 0x20
+
+	// PrevAroundRight is the previous edge around the right facet.
+	PrevAroundRight Subdiv2DEdgeType = 0x02
+)
+
+// Subdiv2D is a wrapper around the cv::Subdiv2D class, that computes the
+// Delaunay triangulation and the Voronoi diagram of a set of 2D points.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+type Subdiv2D struct {
+	p C.Subdiv2D
+}
+
+// NewSubdiv2D returns a new empty Subdiv2D. InitDelaunay must be called
+// before inserting points.
+func NewSubdiv2D() Subdiv2D {
+	return Subdiv2D{p: C.Subdiv2D_New()}
+}
+
+// NewSubdiv2DWithRect returns a new Subdiv2D for the points inside rect.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func NewSubdiv2DWithRect(rect image.Rectangle) Subdiv2D {
+	return Subdiv2D{p: C.Subdiv2D_NewWithRect(toCRect(rect))}
+}
+
+// Close deletes the Subdiv2D's pointer.
+func (s *Subdiv2D) Close() error {
+	C.Subdiv2D_Close(s.p)
+	s.p = nil
+	return nil
+}
+
+// InitDelaunay creates a new empty Delaunay subdivision for the points
+// inside rect.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) InitDelaunay(rect image.Rectangle) {
+	C.Subdiv2D_InitDelaunay(s.p, toCRect(rect))
+}
+
+// Insert inserts a single point into the Delaunay triangulation, and
+// returns the ID of its vertex.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) Insert(pt Point2f) int {
+	return int(C.Subdiv2D_Insert(s.p, toCPoint2f(pt)))
+}
+
+// InsertMultiple inserts the points of pts into the Delaunay triangulation.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) InsertMultiple(pts Point2fVector) {
+	C.Subdiv2D_InsertMultiple(s.p, pts.p)
+}
+
+// Locate returns the location of pt in the Delaunay triangulation. If pt is
+// inside a facet or on an edge, edge is one of the edges of the facet or the
+// edge it lies on. If pt coincides with a vertex, vertex is its ID.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) Locate(pt Point2f) (location Subdiv2DPointLocationType, edge int, vertex int) {
+	var cEdge, cVertex C.int
+	location = Subdiv2DPointLocationType(C.Subdiv2D_Locate(s.p, toCPoint2f(pt), &cEdge, &cVertex))
+	return location, int(cEdge), int(cVertex)
+}
+
+// FindNearest finds the subdivision vertex closest to pt, and returns its
+// ID and its coordinates.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) FindNearest(pt Point2f) (vertex int, nearestPt Point2f) {
+	var cPt C.struct_Point2f
+	vertex = int(C.Subdiv2D_FindNearest(s.p, toCPoint2f(pt), &cPt))
+	return vertex, fromCPoint2f(cPt)
+}
+
+// GetEdgeList returns the list of all the edges, as (x1, y1, x2, y2) end
+// points.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetEdgeList() []Vec4f {
+	cEdges := NewPoint2fVector()
+	defer cEdges.Close()
+
+	C.Subdiv2D_GetEdgeList(s.p, cEdges.p)
+
+	pts := cEdges.ToPoints()
+	edges := make([]Vec4f, len(pts)/2)
+	for i := range edges {
+		p1, p2 := pts[2*i], pts[2*i+1]
+		edges[i] = Vec4f{p1.X, p1.Y, p2.X, p2.Y}
+	}
+	return edges
+}
+
+// GetLeadingEdgeList returns the list of the leading edge IDs, one per
+// triangle.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetLeadingEdgeList() []int {
+	cEdges := C.IntVector{}
+	C.Subdiv2D_GetLeadingEdgeList(s.p, &cEdges)
+	defer C.IntVector_Close(cEdges)
+
+	return toGoInts(cEdges)
+}
+
+// GetTriangleList returns the list of all the triangles, as
+// (x1, y1, x2, y2, x3, y3) vertices.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetTriangleList() []Vec6f {
+	cTriangles := NewPoint2fVector()
+	defer cTriangles.Close()
+
+	C.Subdiv2D_GetTriangleList(s.p, cTriangles.p)
+
+	pts := cTriangles.ToPoints()
+	triangles := make([]Vec6f, len(pts)/3)
+	for i := range triangles {
+		p1, p2, p3 := pts[3*i], pts[3*i+1], pts[3*i+2]
+		triangles[i] = Vec6f{p1.X, p1.Y, p2.X, p2.Y, p3.X, p3.Y}
+	}
+	return triangles
+}
+
+// GetVoronoiFacetList returns the Voronoi facets of the vertices with the
+// given IDs, or of all the vertices if idx is empty, along with the vertices
+// coordinates which are the facets centers.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetVoronoiFacetList(idx []int) (facetList [][]Point2f, facetCenters []Point2f) {
+	cIdxInts := make([]C.int, len(idx)+1)
+	for i, v := range idx {
+		cIdxInts[i] = C.int(v)
+	}
+	cIdx := C.struct_IntVector{
+		val:    &cIdxInts[0],
+		length: C.int(len(idx)),
+	}
+
+	cPoints := NewPoint2fVector()
+	defer cPoints.Close()
+
+	cCenters := NewPoint2fVector()
+	defer cCenters.Close()
+
+	cLengths := C.IntVector{}
+	C.Subdiv2D_GetVoronoiFacetList(s.p, cIdx, cPoints.p, &cLengths, cCenters.p)
+	defer C.IntVector_Close(cLengths)
+
+	pts := cPoints.ToPoints()
+	lengths := toGoInts(cLengths)
+	facetList = make([][]Point2f, len(lengths))
+	for i, n := range lengths {
+		facetList[i] = pts[:n:n]
+		pts = pts[n:]
+	}
+	return facetList, cCenters.ToPoints()
+}
+
+// GetVertex returns the coordinates of the vertex with the given ID, and
+// the ID of one of the edges starting from it.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetVertex(vertex int) (pt Point2f, firstEdge int) {
+	var cFirstEdge C.int
+	cPt := C.Subdiv2D_GetVertex(s.p, C.int(vertex), &cFirstEdge)
+	return fromCPoint2f(cPt), int(cFirstEdge)
+}
+
+// GetEdge returns one of the edges related to edge, as given by
+// nextEdgeType.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) GetEdge(edge int, nextEdgeType Subdiv2DEdgeType) int {
+	return int(C.Subdiv2D_GetEdge(s.p, C.int(edge), C.int(nextEdgeType)))
+}
+
+// NextEdge returns the next edge around the edge origin, which is the same
+// as GetEdge(edge, NextAroundOrg).
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) NextEdge(edge int) int {
+	return int(C.Subdiv2D_NextEdge(s.p, C.int(edge)))
+}
+
+// RotateEdge returns one of the edges of the same quad-edge as edge. rotate
+// is 0 for edge itself, 1 for the rotated edge (dual edge), 2 for the
+// reversed edge and 3 for the reversed rotated edge.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) RotateEdge(edge int, rotate int) int {
+	return int(C.Subdiv2D_RotateEdge(s.p, C.int(edge), C.int(rotate)))
+}
+
+// SymEdge returns the reversed edge of edge.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) SymEdge(edge int) int {
+	return int(C.Subdiv2D_SymEdge(s.p, C.int(edge)))
+}
+
+// EdgeOrg returns the ID and the coordinates of the origin vertex of edge.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) EdgeOrg(edge int) (vertex int, pt Point2f) {
+	var cPt C.struct_Point2f
+	vertex = int(C.Subdiv2D_EdgeOrg(s.p, C.int(edge), &cPt))
+	return vertex, fromCPoint2f(cPt)
+}
+
+// EdgeDst returns the ID and the coordinates of the destination vertex of
+// edge.
+//
+// For further details, please see:
+// https://docs.opencv.org/master/df/dbf/classcv_1_1Subdiv2D.html
+//
+func (s *Subdiv2D) EdgeDst(edge int) (vertex int, pt Point2f) {
+	var cPt C.struct_Point2f
+	vertex = int(C.Subdiv2D_EdgeDst(s.p, C.int(edge), &cPt))
+	return vertex, fromCPoint2f(cPt)
+}
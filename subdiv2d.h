@@ -0,0 +1,43 @@
+#ifndef _OPENCV3_SUBDIV2D_H_
+#define _OPENCV3_SUBDIV2D_H_
+
+#ifdef __cplusplus
+#include <opencv2/opencv.hpp>
+
+extern "C" {
+#endif
+
+#include "core.h"
+
+#ifdef __cplusplus
+typedef cv::Subdiv2D* Subdiv2D;
+#else
+typedef void* Subdiv2D;
+#endif
+
+Subdiv2D Subdiv2D_New();
+Subdiv2D Subdiv2D_NewWithRect(Rect rect);
+void Subdiv2D_Close(Subdiv2D sd);
+void Subdiv2D_InitDelaunay(Subdiv2D sd, Rect rect);
+int Subdiv2D_Insert(Subdiv2D sd, Point2f pt);
+void Subdiv2D_InsertMultiple(Subdiv2D sd, Point2fVector pts);
+int Subdiv2D_Locate(Subdiv2D sd, Point2f pt, int* edge, int* vertex);
+int Subdiv2D_FindNearest(Subdiv2D sd, Point2f pt, Point2f* nearestPt);
+void Subdiv2D_GetEdgeList(Subdiv2D sd, Point2fVector edgeList);
+void Subdiv2D_GetLeadingEdgeList(Subdiv2D sd, IntVector* leadingEdgeList);
+void Subdiv2D_GetTriangleList(Subdiv2D sd, Point2fVector triangleList);
+void Subdiv2D_GetVoronoiFacetList(Subdiv2D sd, IntVector idx, Point2fVector facetPoints, IntVector* facetLengths,
+    Point2fVector facetCenters);
+Point2f Subdiv2D_GetVertex(Subdiv2D sd, int vertex, int* firstEdge);
+int Subdiv2D_GetEdge(Subdiv2D sd, int edge, int nextEdgeType);
+int Subdiv2D_NextEdge(Subdiv2D sd, int edge);
+int Subdiv2D_RotateEdge(Subdiv2D sd, int edge, int rotate);
+int Subdiv2D_SymEdge(Subdiv2D sd, int edge);
+int Subdiv2D_EdgeOrg(Subdiv2D sd, int edge, Point2f* orgPt);
+int Subdiv2D_EdgeDst(Subdiv2D sd, int edge, Point2f* dstPt);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif //_OPENCV3_SUBDIV2D_H_
@@ -0,0 +1,95 @@
+package gocv
+
+import (
+	"image"
+	"testing"
+)
+
+func TestSubdiv2D(t *testing.T) {
+	subdiv := NewSubdiv2DWithRect(image.Rect(0, 0, 100, 100))
+	defer subdiv.Close()
+
+	pts := NewPoint2fVectorFromPoints([]Point2f{{10, 10}, {90, 10}, {50, 80}})
+	defer pts.Close()
+	subdiv.InsertMultiple(pts)
+
+	center := subdiv.Insert(Point2f{50, 40})
+
+	triangles := subdiv.GetTriangleList()
+	inside := 0
+	for _, tri := range triangles {
+		outside := false
+		for _, v := range tri {
+			if v < 0 || v > 100 {
+				outside = true
+			}
+		}
+		if !outside {
+			inside++
+		}
+	}
+	if inside != 3 {
+		t.Errorf("Subdiv2D GetTriangleList: got %v triangles inside the rect, want 3", inside)
+	}
+
+	if len(subdiv.GetEdgeList()) == 0 {
+		t.Error("Subdiv2D GetEdgeList should not be empty")
+	}
+	if len(subdiv.GetLeadingEdgeList()) < len(triangles) {
+		t.Errorf("Subdiv2D GetLeadingEdgeList: got %v edges, want at least %v", len(subdiv.GetLeadingEdgeList()), len(triangles))
+	}
+
+	vertex, nearest := subdiv.FindNearest(Point2f{52, 41})
+	if vertex != center || nearest != (Point2f{50, 40}) {
+		t.Errorf("Subdiv2D FindNearest: got %v %v, want %v %v", vertex, nearest, center, Point2f{50, 40})
+	}
+
+	loc, _, v := subdiv.Locate(Point2f{50, 40})
+	if loc != PtlocVertex || v != center {
+		t.Errorf("Subdiv2D Locate: got %v %v, want %v %v", loc, v, PtlocVertex, center)
+	}
+	if loc, _, _ = subdiv.Locate(Point2f{50, 30}); loc != PtlocInside {
+		t.Errorf("Subdiv2D Locate: got %v, want %v", loc, PtlocInside)
+	}
+
+	pt, firstEdge := subdiv.GetVertex(center)
+	if pt != (Point2f{50, 40}) {
+		t.Errorf("Subdiv2D GetVertex: got %v, want %v", pt, Point2f{50, 40})
+	}
+	if org, _ := subdiv.EdgeOrg(firstEdge); org != center {
+		t.Errorf("Subdiv2D EdgeOrg: got %v, want %v", org, center)
+	}
+	sym := subdiv.SymEdge(firstEdge)
+	if dst, _ := subdiv.EdgeDst(sym); dst != center {
+		t.Errorf("Subdiv2D EdgeDst of SymEdge: got %v, want %v", dst, center)
+	}
+	if subdiv.RotateEdge(firstEdge, 2) != sym {
+		t.Error("Subdiv2D RotateEdge by 2 should be SymEdge")
+	}
+	if subdiv.NextEdge(firstEdge) != subdiv.GetEdge(firstEdge, NextAroundOrg) {
+		t.Error("Subdiv2D NextEdge should be GetEdge with NextAroundOrg")
+	}
+
+	facets, centers := subdiv.GetVoronoiFacetList([]int{center})
+	if len(facets) != 1 || len(centers) != 1 {
+		t.Fatalf("Subdiv2D GetVoronoiFacetList: got %v facets and %v centers, want 1", len(facets), len(centers))
+	}
+	if centers[0] != (Point2f{50, 40}) || len(facets[0]) != 3 {
+		t.Errorf("Subdiv2D GetVoronoiFacetList: got center %v with %v points", centers[0], len(facets[0]))
+	}
+
+	all, _ := subdiv.GetVoronoiFacetList(nil)
+	if len(all) != 4 {
+		t.Errorf("Subdiv2D GetVoronoiFacetList: got %v facets, want 4", len(all))
+	}
+}
+
+func TestSubdiv2DInitDelaunay(t *testing.T) {
+	subdiv := NewSubdiv2D()
+	defer subdiv.Close()
+
+	subdiv.InitDelaunay(image.Rect(0, 0, 10, 10))
+	if loc, _, _ := subdiv.Locate(Point2f{20, 20}); loc != PtlocOutsideRect {
+		t.Errorf("Subdiv2D Locate: got %v, want %v", loc, PtlocOutsideRect)
+	}
+}
@@ -2,4 +2,16 @@
 
 void SVD_Compute(Mat src, Mat w, Mat u, Mat vt) {
     cv::SVD::compute(*src, *w, *u, *vt, 0);
+}
+
+void SVD_Decomp(Mat src, Mat w, Mat u, Mat vt, int flags) {
+    cv::SVDecomp(*src, *w, *u, *vt, flags);
+}
+
+void SVD_BackSubst(Mat w, Mat u, Mat vt, Mat rhs, Mat dst) {
+    cv::SVBackSubst(*w, *u, *vt, *rhs, *dst);
+}
+
+void SVD_SolveZ(Mat src, Mat dst) {
+    cv::SVD::solveZ(*src, *dst);
 }
\ No newline at end of file
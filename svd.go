@@ -6,9 +6,81 @@ package gocv
 */
 import "C"
 
+// SVDFlags are the operation flags for the singular value decomposition.
+//
+// For further details, please see:
+// https://docs.opencv.org/4.1.2/df/df7/classcv_1_1SVD.html
+type SVDFlags int
+
+const (
+	// SVDModifyA allows the algorithm to modify the decomposed matrix, which
+	// can save space and speed up processing.
+	SVDModifyA SVDFlags = 1
+
+	// SVDNoUV indicates that only a vector of singular values w is to be
+	// processed, while u and vt will be set to empty matrices.
+	SVDNoUV SVDFlags = 2
+
+	// SVDFullUV indicates that, when the matrix is not square, the algorithm
+	// produces u and vt matrices of sufficiently large size for further A
+	// reconstruction.
+	SVDFullUV SVDFlags = 4
+)
+
 // SVDCompute decomposes matrix and stores the results to user-provided matrices
 //
 // https://docs.opencv.org/4.1.2/df/df7/classcv_1_1SVD.html#a76f0b2044df458160292045a3d3714c6
 func SVDCompute(src Mat, w, u, vt *Mat) {
 	C.SVD_Compute(src.Ptr(), w.Ptr(), u.Ptr(), vt.Ptr())
 }
+
+// SVDecomp decomposes matrix using the given SVDFlags and stores the
+// results to user-provided matrices
+//
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gab477b5b7b39b370bb03e75b19d2d5109
+func SVDecomp(src Mat, w, u, vt *Mat, flags SVDFlags) {
+	C.SVD_Decomp(src.Ptr(), w.Ptr(), u.Ptr(), vt.Ptr(), C.int(flags))
+}
+
+// SVBackSubst performs back substitution for a previously computed SVD,
+// solving the (possibly overdetermined) system src*dst = rhs in the
+// least-squares sense.
+//
+// https://docs.opencv.org/master/d2/de8/group__core__array.html#gab4e620e6fc6c8a27bb2be3d50a840c0b
+func SVBackSubst(w, u, vt, rhs Mat, dst *Mat) {
+	C.SVD_BackSubst(w.Ptr(), u.Ptr(), vt.Ptr(), rhs.Ptr(), dst.Ptr())
+}
+
+// SVDSolveZ solves an under-determined singular linear system src*dst = 0,
+// finding the unit-length solution that minimizes the norm of src*dst.
+//
+// https://docs.opencv.org/4.1.2/df/df7/classcv_1_1SVD.html
+func SVDSolveZ(src Mat, dst *Mat) {
+	C.SVD_SolveZ(src.Ptr(), dst.Ptr())
+}
+
+// SVDSolve solves the linear system src*dst = rhs in the least-squares
+// sense using the singular value decomposition of src. Unlike Solve, the
+// system may be overdetermined and src may be singular.
+func SVDSolve(src, rhs Mat, dst *Mat) {
+	w := NewMat()
+	defer w.Close()
+
+	u := NewMat()
+	defer u.Close()
+
+	vt := NewMat()
+	defer vt.Close()
+
+	SVDecomp(src, &w, &u, &vt, 0)
+	SVBackSubst(w, u, vt, rhs, dst)
+}
+
+// PseudoInverse computes the Moore-Penrose pseudo-inverse of a floating
+// point matrix. For an MxN src, dst will be an NxM matrix of the same type.
+func PseudoInverse(src Mat, dst *Mat) {
+	eye := Eye(src.Rows(), src.Rows(), src.Type())
+	defer eye.Close()
+
+	SVDSolve(src, eye, dst)
+}
@@ -10,6 +10,9 @@ extern "C" {
 #include "core.h"
 
 void SVD_Compute(Mat src, Mat w, Mat u, Mat vt);
+void SVD_Decomp(Mat src, Mat w, Mat u, Mat vt, int flags);
+void SVD_BackSubst(Mat w, Mat u, Mat vt, Mat rhs, Mat dst);
+void SVD_SolveZ(Mat src, Mat dst);
 
 #ifdef __cplusplus
 }
@@ -1,6 +1,7 @@
 package gocv
 
 import (
+	"math"
 	"testing"
 )
 
@@ -67,3 +68,126 @@ func TestSVDCompute(t *testing.T) {
 		t.Error("vt value is incorrect")
 	}
 }
+
+func TestSVDecomp(t *testing.T) {
+	src := NewMatWithSize(3, 2, MatTypeCV64F)
+	defer src.Close()
+	src.SetDoubleAt(0, 0, 1)
+	src.SetDoubleAt(0, 1, 2)
+	src.SetDoubleAt(1, 0, 3)
+	src.SetDoubleAt(1, 1, 4)
+	src.SetDoubleAt(2, 0, 5)
+	src.SetDoubleAt(2, 1, 6)
+
+	w := NewMat()
+	defer w.Close()
+
+	u := NewMat()
+	defer u.Close()
+
+	vt := NewMat()
+	defer vt.Close()
+
+	SVDecomp(src, &w, &u, &vt, SVDFullUV)
+	if w.Rows() != 2 || u.Rows() != 3 || u.Cols() != 3 || vt.Rows() != 2 || vt.Cols() != 2 {
+		t.Errorf("invalid SVDecomp full uv sizes: w=%d u=%dx%d vt=%dx%d", w.Rows(), u.Rows(), u.Cols(), vt.Rows(), vt.Cols())
+	}
+
+	SVDecomp(src, &w, &u, &vt, SVDNoUV)
+	if w.Rows() != 2 {
+		t.Errorf("invalid SVDecomp no uv w size: %d", w.Rows())
+	}
+	if !u.Empty() || !vt.Empty() {
+		t.Error("SVDecomp with SVDNoUV should return empty u and vt")
+	}
+}
+
+func TestSVDSolve(t *testing.T) {
+	// fit y = a + b*x to the points (1, 1), (2, 2), (3, 2)
+	a := NewMatWithSize(3, 2, MatTypeCV64F)
+	defer a.Close()
+	b := NewMatWithSize(3, 1, MatTypeCV64F)
+	defer b.Close()
+	for i, y := range []float64{1, 2, 2} {
+		a.SetDoubleAt(i, 0, 1)
+		a.SetDoubleAt(i, 1, float64(i+1))
+		b.SetDoubleAt(i, 0, y)
+	}
+
+	x := NewMat()
+	defer x.Close()
+
+	SVDSolve(a, b, &x)
+	if x.Rows() != 2 || x.Cols() != 1 {
+		t.Fatalf("invalid SVDSolve result size: %dx%d", x.Rows(), x.Cols())
+	}
+	if math.Abs(x.GetDoubleAt(0, 0)-2.0/3.0) > 1e-9 || math.Abs(x.GetDoubleAt(1, 0)-0.5) > 1e-9 {
+		t.Errorf("invalid SVDSolve result: %v %v", x.GetDoubleAt(0, 0), x.GetDoubleAt(1, 0))
+	}
+
+	w := NewMat()
+	defer w.Close()
+	u := NewMat()
+	defer u.Close()
+	vt := NewMat()
+	defer vt.Close()
+	SVDecomp(a, &w, &u, &vt, 0)
+
+	x2 := NewMat()
+	defer x2.Close()
+	SVBackSubst(w, u, vt, b, &x2)
+	if math.Abs(x2.GetDoubleAt(1, 0)-0.5) > 1e-9 {
+		t.Errorf("invalid SVBackSubst result: %v", x2.GetDoubleAt(1, 0))
+	}
+}
+
+func TestPseudoInverse(t *testing.T) {
+	src := NewMatWithSize(3, 2, MatTypeCV64F)
+	defer src.Close()
+	src.SetDoubleAt(0, 0, 1)
+	src.SetDoubleAt(0, 1, 2)
+	src.SetDoubleAt(1, 0, 3)
+	src.SetDoubleAt(1, 1, 4)
+	src.SetDoubleAt(2, 0, 5)
+	src.SetDoubleAt(2, 1, 6)
+
+	pinv := NewMat()
+	defer pinv.Close()
+
+	PseudoInverse(src, &pinv)
+	if pinv.Rows() != 2 || pinv.Cols() != 3 {
+		t.Fatalf("invalid PseudoInverse size: %dx%d", pinv.Rows(), pinv.Cols())
+	}
+
+	id := pinv.MultiplyMatrix(src)
+	defer id.Close()
+	for r := 0; r < 2; r++ {
+		for c := 0; c < 2; c++ {
+			expected := 0.0
+			if r == c {
+				expected = 1.0
+			}
+			if math.Abs(id.GetDoubleAt(r, c)-expected) > 1e-9 {
+				t.Errorf("PseudoInverse * src should be identity, got %v at %d,%d", id.GetDoubleAt(r, c), r, c)
+			}
+		}
+	}
+}
+
+func TestSVDSolveZ(t *testing.T) {
+	src := NewMatWithSize(2, 3, MatTypeCV64F)
+	defer src.Close()
+	src.SetDoubleAt(0, 0, 1)
+	src.SetDoubleAt(1, 1, 1)
+
+	dst := NewMat()
+	defer dst.Close()
+
+	SVDSolveZ(src, &dst)
+	if dst.Rows() != 3 || dst.Cols() != 1 {
+		t.Fatalf("invalid SVDSolveZ size: %dx%d", dst.Rows(), dst.Cols())
+	}
+	if math.Abs(math.Abs(dst.GetDoubleAt(2, 0))-1) > 1e-9 {
+		t.Errorf("invalid SVDSolveZ result: %v", dst.GetDoubleAt(2, 0))
+	}
+}